@@ -31,3 +31,8 @@ package error
 import "errors"
 
 var INVALID_ARGUMENT = errors.New("INVALID_ARGUMENT")
+var NEGATIVE_WEIGHT = errors.New("NEGATIVE_WEIGHT")
+var NOT_DAG = errors.New("NOT_DAG")
+var NOT_BIPARTITE = errors.New("NOT_BIPARTITE")
+var NEGATIVE_CYCLE = errors.New("NEGATIVE_CYCLE")
+var NOT_EULERIAN = errors.New("NOT_EULERIAN")
@@ -0,0 +1,141 @@
+/*
+
+astar.go
+
+MIT License
+
+Copyright (c) 2018 rezamirz
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package graph
+
+import (
+	"fmt"
+	"math"
+
+	algo_error "github.com/rezamirz/myalgos/error"
+	"github.com/rezamirz/myalgos/util"
+)
+
+// Estimate of the length of the shortest path from v to the target
+type Heuristic func(v int) float64
+
+// A* point to point shortest path in an edge weighted digraph with non-negative
+// weights. The open set is ordered by g(v) + h(v) where g(v) is the length of
+// the best path found from the source. The path is the shortest if h never
+// overestimates, a vertex is expanded again if a shorter path to it is found.
+type AStar struct {
+	found    bool
+	cost     float64        // Length of the path found
+	path     []DirectedEdge // Edges of the path found
+	expanded int            // Number of vertices removed from the open set
+}
+
+// A nil h is the zero heuristic, then A* expands the vertices like Dijkstra.
+// Returns an error wrapping NEGATIVE_WEIGHT if it encounters an edge with
+// negative weight.
+func NewAStar(g *EdgeWeightedDigraph, s, t int, h Heuristic) (*AStar, error) {
+	if !g.HasVertex(s) || !g.HasVertex(t) {
+		return nil, algo_error.INVALID_ARGUMENT
+	}
+
+	if h == nil {
+		h = func(v int) float64 { return 0 }
+	}
+
+	n := g.GetNumVertices()
+	gScore := make([]float64, n)
+	edgeTo := make([]DirectedEdge, n)
+	for v := range gScore {
+		gScore[v] = math.Inf(1)
+	}
+	gScore[s] = 0
+
+	as := &AStar{
+		cost: math.Inf(1),
+	}
+
+	open := util.NewHashedPQ(util.MinPQ, n)
+	open.Put(s, floatPriority(h(s)))
+	for !open.IsEmpty() {
+		key, _, err := open.Dequeue()
+		if err != nil {
+			return nil, err
+		}
+
+		v := key.(int)
+		as.expanded++
+		if v == t {
+			as.found = true
+			break
+		}
+
+		for _, e := range g.Adj(v) {
+			if e.Weight() < 0 {
+				return nil, fmt.Errorf("edge %v: %w", e, algo_error.NEGATIVE_WEIGHT)
+			}
+
+			w := e.To()
+			score := gScore[v] + e.Weight()
+			if score >= gScore[w] {
+				continue
+			}
+
+			gScore[w] = score
+			edgeTo[w] = e
+			if _, err := open.Put(w, floatPriority(score+h(w))); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if as.found {
+		as.cost = gScore[t]
+		as.path = []DirectedEdge{}
+		for v := t; v != s; v = edgeTo[v].From() {
+			as.path = append(as.path, edgeTo[v])
+		}
+		reverseEdges(as.path)
+	}
+
+	return as, nil
+}
+
+// Returns true if the target is reachable from the source
+func (as *AStar) Found() bool {
+	return as.found
+}
+
+// Returns the length of the path, +Inf if the target is not reachable
+func (as *AStar) Cost() float64 {
+	return as.cost
+}
+
+// Returns the edges of the path, nil if the target is not reachable
+func (as *AStar) Path() []DirectedEdge {
+	return as.path
+}
+
+// Returns the number of vertices expanded by the search
+func (as *AStar) Expanded() int {
+	return as.expanded
+}
@@ -0,0 +1,82 @@
+package graph
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	algo_error "github.com/rezamirz/myalgos/error"
+	"github.com/stretchr/testify/assert"
+)
+
+// Grid of size x size vertices where v = row * size + col, moving to a
+// neighbor cell costs between 1 and 2.
+func gridGraph(size int, r *rand.Rand) *EdgeWeightedDigraph {
+	g := NewEdgeWeightedDigraph(size * size)
+	for row := 0; row < size; row++ {
+		for col := 0; col < size; col++ {
+			v := row*size + col
+			if col+1 < size {
+				g.AddWeightedEdge(NewDirectedEdge(v, v+1, 1+r.Float64()))
+				g.AddWeightedEdge(NewDirectedEdge(v+1, v, 1+r.Float64()))
+			}
+			if row+1 < size {
+				g.AddWeightedEdge(NewDirectedEdge(v, v+size, 1+r.Float64()))
+				g.AddWeightedEdge(NewDirectedEdge(v+size, v, 1+r.Float64()))
+			}
+		}
+	}
+
+	return g
+}
+
+func TestAStar_Grid(t *testing.T) {
+	const size = 30
+	g := gridGraph(size, rand.New(rand.NewSource(1)))
+	s, target := 0, size*size-1
+
+	manhattan := func(v int) float64 {
+		return math.Abs(float64(v/size-target/size)) + math.Abs(float64(v%size-target%size))
+	}
+
+	sp, err := NewDijkstraSP(g, s)
+	assert.NoError(t, err)
+
+	as, err := NewAStar(g, s, target, manhattan)
+	assert.NoError(t, err)
+	assert.Equal(t, true, as.Found())
+	assert.InDelta(t, sp.DistTo(target), as.Cost(), 1e-9)
+	assert.InDelta(t, as.Cost(), pathWeight(as.Path()), 1e-9)
+	assert.Equal(t, s, as.Path()[0].From())
+	assert.Equal(t, target, as.Path()[len(as.Path())-1].To())
+
+	dijkstra, err := NewAStar(g, s, target, nil)
+	assert.NoError(t, err)
+	assert.InDelta(t, sp.DistTo(target), dijkstra.Cost(), 1e-9)
+	assert.Equal(t, true, as.Expanded() < dijkstra.Expanded())
+}
+
+func TestAStar_NotFound(t *testing.T) {
+	g := NewEdgeWeightedDigraph(3)
+	g.AddWeightedEdge(NewDirectedEdge(0, 1, 1))
+
+	as, err := NewAStar(g, 0, 2, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, false, as.Found())
+	assert.Equal(t, math.Inf(1), as.Cost())
+	assert.Nil(t, as.Path())
+	assert.Equal(t, 2, as.Expanded())
+
+	as, err = NewAStar(g, 1, 1, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 0.0, as.Cost())
+	assert.Equal(t, []DirectedEdge{}, as.Path())
+
+	_, err = NewAStar(g, 0, 3, nil)
+	assert.Equal(t, algo_error.INVALID_ARGUMENT, err)
+
+	g.AddWeightedEdge(NewDirectedEdge(1, 2, -1))
+	_, err = NewAStar(g, 0, 2, nil)
+	assert.Equal(t, true, errors.Is(err, algo_error.NEGATIVE_WEIGHT))
+}
@@ -0,0 +1,193 @@
+/*
+
+bellman_ford.go
+
+MIT License
+
+Copyright (c) 2018 rezamirz
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package graph
+
+import (
+	"math"
+
+	algo_error "github.com/rezamirz/myalgos/error"
+	"github.com/rezamirz/myalgos/util"
+)
+
+// Queue based Bellman-Ford single source shortest paths in an edge weighted
+// digraph, the weights can be negative.
+type BellmanFordSP struct {
+	s       int            // Source vertex
+	distTo  []float64      // Distance of the shortest path from s to v
+	edgeTo  []DirectedEdge // Last edge on the shortest path from s to v
+	hasEdge []bool         // Is edgeTo[v] set
+	onQueue []bool         // Is v on the queue
+	q       *util.Queue    // Vertices being relaxed
+	cost    int            // Number of calls to relax()
+	cycle   []DirectedEdge // Negative cycle reachable from s if there is any
+}
+
+func NewBellmanFordSP(g *EdgeWeightedDigraph, s int) (*BellmanFordSP, error) {
+	if !g.HasVertex(s) {
+		return nil, algo_error.INVALID_ARGUMENT
+	}
+
+	n := g.GetNumVertices()
+	sp := &BellmanFordSP{
+		s:       s,
+		distTo:  make([]float64, n),
+		edgeTo:  make([]DirectedEdge, n),
+		hasEdge: make([]bool, n),
+		onQueue: make([]bool, n),
+		q:       util.NewQueue(),
+	}
+
+	for v := range sp.distTo {
+		sp.distTo[v] = math.Inf(1)
+	}
+	sp.distTo[s] = 0
+
+	sp.q.Push(s)
+	sp.onQueue[s] = true
+	for sp.q.Len() > 0 && !sp.HasNegativeCycle() {
+		v := sp.q.Pop().(int)
+		sp.onQueue[v] = false
+		sp.relax(g, v)
+	}
+
+	return sp, nil
+}
+
+func (sp *BellmanFordSP) relax(g *EdgeWeightedDigraph, v int) {
+	for _, e := range g.Adj(v) {
+		w := e.To()
+		if sp.distTo[w] > sp.distTo[v]+e.Weight() {
+			sp.distTo[w] = sp.distTo[v] + e.Weight()
+			sp.edgeTo[w] = e
+			sp.hasEdge[w] = true
+			if !sp.onQueue[w] {
+				sp.q.Push(w)
+				sp.onQueue[w] = true
+			}
+		}
+
+		// Check for a negative cycle in the shortest paths tree every V relaxations
+		sp.cost++
+		if sp.cost%g.GetNumVertices() == 0 {
+			sp.findNegativeCycle()
+			if sp.HasNegativeCycle() {
+				return
+			}
+		}
+	}
+}
+
+// Looks for a cycle in the graph formed by edgeTo, any such cycle is negative
+func (sp *BellmanFordSP) findNegativeCycle() {
+	const (
+		unvisited = iota
+		onWalk
+		done
+	)
+
+	state := make([]int, len(sp.edgeTo))
+	for v := range sp.edgeTo {
+		if state[v] != unvisited {
+			continue
+		}
+
+		// Walk the parent edges from v until reaching a visited vertex
+		w := v
+		for state[w] == unvisited {
+			state[w] = onWalk
+			if !sp.hasEdge[w] {
+				break
+			}
+			w = sp.edgeTo[w].From()
+		}
+
+		if state[w] == onWalk && sp.hasEdge[w] {
+			// w is on a cycle formed in this walk
+			cycle := []DirectedEdge{}
+			u := w
+			for {
+				e := sp.edgeTo[u]
+				cycle = append(cycle, e)
+				u = e.From()
+				if u == w {
+					break
+				}
+			}
+			reverseEdges(cycle)
+			sp.cycle = cycle
+			return
+		}
+
+		for w = v; state[w] == onWalk; {
+			state[w] = done
+			if !sp.hasEdge[w] {
+				break
+			}
+			w = sp.edgeTo[w].From()
+		}
+	}
+}
+
+// Returns the length of the shortest path from the source to v, +Inf if there
+// is no path. The result is not meaningful if there is a negative cycle.
+func (sp *BellmanFordSP) DistTo(v int) float64 {
+	return sp.distTo[v]
+}
+
+func (sp *BellmanFordSP) HasPathTo(v int) bool {
+	return sp.distTo[v] < math.Inf(1)
+}
+
+// Returns the edges on the shortest path from the source to v, nil if there is
+// no path or there is a negative cycle.
+func (sp *BellmanFordSP) PathTo(v int) []DirectedEdge {
+	if sp.HasNegativeCycle() || !sp.HasPathTo(v) {
+		return nil
+	}
+
+	path := []DirectedEdge{}
+	for v != sp.s {
+		e := sp.edgeTo[v]
+		path = append(path, e)
+		v = e.From()
+	}
+
+	reverseEdges(path)
+	return path
+}
+
+// Returns true if there is a negative cycle reachable from the source
+func (sp *BellmanFordSP) HasNegativeCycle() bool {
+	return sp.cycle != nil
+}
+
+// Returns the edges of a negative cycle reachable from the source, nil if there is none
+func (sp *BellmanFordSP) NegativeCycle() []DirectedEdge {
+	return sp.cycle
+}
@@ -0,0 +1,67 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBellmanFordSP_NegativeWeights(t *testing.T) {
+	g, err := readFile("data/tinyEWDn.txt", true)
+	assert.NoError(t, err)
+
+	sp, err := NewBellmanFordSP(g.(*EdgeWeightedDigraph), 0)
+	assert.NoError(t, err)
+	assert.Equal(t, false, sp.HasNegativeCycle())
+	assert.Nil(t, sp.NegativeCycle())
+
+	expected := []float64{0, 0.93, 0.26, 0.99, 0.26, 0.61, 1.51, 0.60}
+	for v, dist := range expected {
+		assert.Equal(t, true, sp.HasPathTo(v))
+		assert.InDelta(t, dist, sp.DistTo(v), 1e-9)
+	}
+
+	assert.Equal(t, []DirectedEdge{
+		NewDirectedEdge(0, 2, 0.26),
+		NewDirectedEdge(2, 7, 0.34),
+		NewDirectedEdge(7, 3, 0.39),
+		NewDirectedEdge(3, 6, 0.52),
+		NewDirectedEdge(6, 4, -1.25),
+		NewDirectedEdge(4, 5, 0.35),
+		NewDirectedEdge(5, 1, 0.32),
+	}, sp.PathTo(1))
+}
+
+func TestBellmanFordSP_NegativeCycle(t *testing.T) {
+	g, err := readFile("data/tinyEWDnc.txt", true)
+	assert.NoError(t, err)
+
+	sp, err := NewBellmanFordSP(g.(*EdgeWeightedDigraph), 0)
+	assert.NoError(t, err)
+	assert.Equal(t, true, sp.HasNegativeCycle())
+	assert.Nil(t, sp.PathTo(5))
+
+	cycle := sp.NegativeCycle()
+	assert.Equal(t, 2, len(cycle))
+	weight := 0.0
+	for i, e := range cycle {
+		assert.Equal(t, e.To(), cycle[(i+1)%len(cycle)].From())
+		weight += e.Weight()
+	}
+	assert.Equal(t, true, weight < 0)
+}
+
+func TestBellmanFordSP_Unreachable(t *testing.T) {
+	g := NewEdgeWeightedDigraph(4)
+	g.AddWeightedEdge(NewDirectedEdge(0, 1, -2))
+	g.AddWeightedEdge(NewDirectedEdge(2, 3, -1))
+	g.AddWeightedEdge(NewDirectedEdge(3, 2, -1))
+
+	// The negative cycle 2-3 is not reachable from 0
+	sp, err := NewBellmanFordSP(g, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, false, sp.HasNegativeCycle())
+	assert.Equal(t, -2.0, sp.DistTo(1))
+	assert.Equal(t, false, sp.HasPathTo(2))
+	assert.Nil(t, sp.PathTo(3))
+}
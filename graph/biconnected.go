@@ -0,0 +1,190 @@
+/*
+
+biconnected.go
+
+MIT License
+
+Copyright (c) 2018 rezamirz
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package graph
+
+// Articulation points, bridges and biconnected components of an undirected
+// graph with Tarjan's low-link values. low[v] is the lowest preorder number
+// reachable from the subtree of v with at most one back edge, so the parent p
+// of v separates the subtree if low[v] >= pre[p]. The depth first search uses
+// an explicit stack.
+//
+// The edges are reported as Edge values with weight 1, self-loops are ignored.
+type Biconnected struct {
+	pre          []int    // Preorder number of v, -1 if v is not visited
+	low          []int    // Low-link value of v
+	articulation []bool   // Is v an articulation point
+	bridges      []Edge   // Edges whose removal disconnects the graph
+	components   [][]Edge // Edges of each biconnected component
+	edgeStack    []Edge
+	count        int // Number of visited vertices
+}
+
+// A vertex on the DFS stack with its parent, the first edge back to the
+// parent is the tree edge and is skipped, the other ones are parallel edges.
+type biconnectedFrame struct {
+	dfsFrame
+	parent        int
+	parentSkipped bool
+}
+
+func NewBiconnected(g Graph) *Biconnected {
+	n := g.GetNumVertices()
+	bc := &Biconnected{
+		pre:          make([]int, n),
+		low:          make([]int, n),
+		articulation: make([]bool, n),
+	}
+
+	for v := range bc.pre {
+		bc.pre[v] = -1
+	}
+
+	for s := 0; s < n; s++ {
+		if bc.pre[s] < 0 {
+			bc.doSearch(g, s)
+		}
+	}
+
+	return bc
+}
+
+func (bc *Biconnected) visit(v int) {
+	bc.pre[v] = bc.count
+	bc.low[v] = bc.count
+	bc.count++
+}
+
+func (bc *Biconnected) doSearch(g Graph, s int) {
+	children := 0
+	bc.visit(s)
+	stack := []biconnectedFrame{{dfsFrame: dfsFrame{v: s, neighbors: neighbors(g, s)}, parent: -1}}
+
+	for len(stack) > 0 {
+		top := &stack[len(stack)-1]
+		v := top.v
+		if top.next < len(top.neighbors) {
+			w := top.neighbors[top.next]
+			top.next++
+
+			switch {
+			case w == top.parent && !top.parentSkipped:
+				top.parentSkipped = true
+			case bc.pre[w] < 0:
+				bc.edgeStack = append(bc.edgeStack, NewEdge(v, w, 1))
+				bc.visit(w)
+				if v == s {
+					children++
+				}
+				stack = append(stack, biconnectedFrame{dfsFrame: dfsFrame{v: w, neighbors: neighbors(g, w)}, parent: v})
+			case bc.pre[w] < bc.pre[v]:
+				// Back edge to an ancestor
+				bc.edgeStack = append(bc.edgeStack, NewEdge(v, w, 1))
+				if bc.pre[w] < bc.low[v] {
+					bc.low[v] = bc.pre[w]
+				}
+			}
+			continue
+		}
+
+		stack = stack[:len(stack)-1]
+		p := top.parent
+		if p < 0 {
+			continue
+		}
+
+		if bc.low[v] < bc.low[p] {
+			bc.low[p] = bc.low[v]
+		}
+
+		if bc.low[v] >= bc.pre[p] {
+			if p != s {
+				bc.articulation[p] = true
+			}
+			bc.popComponent(p, v)
+		}
+
+		if bc.low[v] > bc.pre[p] {
+			bc.bridges = append(bc.bridges, NewEdge(p, v, 1))
+		}
+	}
+
+	if children > 1 {
+		bc.articulation[s] = true
+	}
+}
+
+// Pops the edges of the component up to the tree edge p-v
+func (bc *Biconnected) popComponent(p, v int) {
+	component := []Edge{}
+	for {
+		e := bc.edgeStack[len(bc.edgeStack)-1]
+		bc.edgeStack = bc.edgeStack[:len(bc.edgeStack)-1]
+		component = append(component, e)
+		if e.Either() == p && e.Other(p) == v {
+			break
+		}
+	}
+
+	bc.components = append(bc.components, component)
+}
+
+func (bc *Biconnected) IsArticulation(v int) bool {
+	return bc.articulation[v]
+}
+
+// Returns the vertices whose removal disconnects their component
+func (bc *Biconnected) ArticulationPoints() []int {
+	points := []int{}
+	for v, isArticulation := range bc.articulation {
+		if isArticulation {
+			points = append(points, v)
+		}
+	}
+
+	return points
+}
+
+// Returns the edges whose removal disconnects their component
+func (bc *Biconnected) Bridges() []Edge {
+	bridges := make([]Edge, len(bc.bridges))
+	copy(bridges, bc.bridges)
+	return bridges
+}
+
+// Returns the edges of every biconnected component, every edge except the
+// self-loops belongs to exactly one component.
+func (bc *Biconnected) Components() [][]Edge {
+	components := make([][]Edge, len(bc.components))
+	for i, component := range bc.components {
+		components[i] = make([]Edge, len(component))
+		copy(components[i], component)
+	}
+
+	return components
+}
@@ -0,0 +1,140 @@
+package graph
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Returns the number of components of g without the vertex x and the edge skip
+func countWithout(g Graph, x, skip int) int {
+	n := g.GetNumVertices()
+	ug := NewUGraph(n)
+	edge := 0
+	for v := 0; v < n; v++ {
+		for _, w := range g.GetNeighbors(v) {
+			if w < v || v == x || w == x {
+				continue
+			}
+			if edge != skip {
+				ug.AddEdge(v, w)
+			}
+			edge++
+		}
+	}
+
+	count := NewCC(ug).Count()
+	if x >= 0 {
+		count--
+	}
+	return count
+}
+
+func assertBiconnected(t *testing.T, g Graph) {
+	bc := NewBiconnected(g)
+	count := countWithout(g, -1, -1)
+
+	for v := 0; v < g.GetNumVertices(); v++ {
+		assert.Equal(t, countWithout(g, v, -1) > count, bc.IsArticulation(v), v)
+	}
+
+	bridges := map[[2]int]bool{}
+	for _, e := range bc.Bridges() {
+		v := e.Either()
+		w := e.Other(v)
+		if w < v {
+			v, w = w, v
+		}
+		bridges[[2]int{v, w}] = true
+	}
+
+	edges := 0
+	for v := 0; v < g.GetNumVertices(); v++ {
+		for _, w := range g.GetNeighbors(v) {
+			if w < v {
+				continue
+			}
+			if w != v {
+				assert.Equal(t, countWithout(g, -1, edges) > count, bridges[[2]int{v, w}])
+			}
+			edges++
+		}
+	}
+
+	// Every edge except the self-loops is in one component, no vertex other than
+	// an articulation point is shared by two components
+	inComponents := 0
+	owner := map[int]int{}
+	for i, component := range bc.Components() {
+		inComponents += len(component)
+		vertices := map[int]bool{}
+		for _, e := range component {
+			vertices[e.Either()] = true
+			vertices[e.Other(e.Either())] = true
+		}
+		for v := range vertices {
+			if j, ok := owner[v]; ok && j != i {
+				assert.Equal(t, true, bc.IsArticulation(v))
+			}
+			owner[v] = i
+		}
+	}
+
+	selfLoops := 0
+	for v := 0; v < g.GetNumVertices(); v++ {
+		for _, w := range g.GetNeighbors(v) {
+			if w == v {
+				selfLoops++
+			}
+		}
+	}
+	assert.Equal(t, g.GetNumEdges()-selfLoops, inComponents)
+}
+
+func TestBiconnected(t *testing.T) {
+	// Two triangles joined by the bridge 2-3, a parallel edge 5-6 and a tail 6-7
+	g := NewUGraph(9)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 0)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 4)
+	g.AddEdge(4, 5)
+	g.AddEdge(5, 3)
+	g.AddEdge(5, 6)
+	g.AddEdge(6, 5)
+	g.AddEdge(6, 7)
+	g.AddEdge(7, 7)
+
+	bc := NewBiconnected(g)
+	assert.Equal(t, []int{2, 3, 5, 6}, bc.ArticulationPoints())
+	assert.ElementsMatch(t, []Edge{NewEdge(2, 3, 1), NewEdge(6, 7, 1)}, bc.Bridges())
+	assert.Equal(t, 5, len(bc.Components()))
+	assertBiconnected(t, g)
+}
+
+func TestBiconnected_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 30; i++ {
+		n := 1 + r.Intn(15)
+		g := NewUGraph(n)
+		for e := r.Intn(2 * n); e > 0; e-- {
+			g.AddEdge(r.Intn(n), r.Intn(n))
+		}
+		assertBiconnected(t, g)
+	}
+}
+
+func TestBiconnected_DeepGraph(t *testing.T) {
+	const n = 1000000
+	g := NewUGraph(n)
+	for v := 0; v+1 < n; v++ {
+		g.AddEdge(v, v+1)
+	}
+
+	bc := NewBiconnected(g)
+	assert.Equal(t, n-2, len(bc.ArticulationPoints()))
+	assert.Equal(t, n-1, len(bc.Bridges()))
+	assert.Equal(t, n-1, len(bc.Components()))
+}
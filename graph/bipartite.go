@@ -0,0 +1,118 @@
+/*
+
+bipartite.go
+
+MIT License
+
+Copyright (c) 2018 rezamirz
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package graph
+
+import "github.com/rezamirz/myalgos/util"
+
+// Two-colors the vertices of an undirected graph with breadth first search so
+// that no edge connects two vertices of the same color. If it is not possible
+// the graph has an odd length cycle.
+type Bipartite struct {
+	isBipartite bool
+	color       []bool // Color of v
+	marked      []bool // is the node already marked / visited
+	edgeTo      []int  // Parent of v in the BFS tree
+	distTo      []int  // Depth of v in the BFS tree
+	cycle       []int  // Odd length cycle if the graph is not bipartite
+}
+
+func NewBipartite(g Graph) *Bipartite {
+	n := g.GetNumVertices()
+	b := &Bipartite{
+		isBipartite: true,
+		color:       make([]bool, n),
+		marked:      make([]bool, n),
+		edgeTo:      make([]int, n),
+		distTo:      make([]int, n),
+	}
+
+	for v := 0; v < n && b.isBipartite; v++ {
+		if !b.marked[v] {
+			b.doSearch(g, v)
+		}
+	}
+
+	return b
+}
+
+func (b *Bipartite) doSearch(g Graph, s int) {
+	q := util.NewQueue()
+	b.marked[s] = true
+	q.Push(s)
+
+	for q.Len() > 0 {
+		v := q.Pop().(int)
+		for _, w := range neighbors(g, v) {
+			if !b.marked[w] {
+				b.marked[w] = true
+				b.edgeTo[w] = v
+				b.distTo[w] = b.distTo[v] + 1
+				b.color[w] = !b.color[v]
+				q.Push(w)
+			} else if b.color[w] == b.color[v] {
+				b.isBipartite = false
+				b.cycle = b.oddCycle(v, w)
+				return
+			}
+		}
+	}
+}
+
+// Returns the cycle formed by the edge v-w and the tree paths to their common ancestor
+func (b *Bipartite) oddCycle(v, w int) []int {
+	pathV := []int{v}
+	pathW := []int{w}
+	for v != w {
+		if b.distTo[v] >= b.distTo[w] {
+			v = b.edgeTo[v]
+			pathV = append(pathV, v)
+		} else {
+			w = b.edgeTo[w]
+			pathW = append(pathW, w)
+		}
+	}
+
+	reverse(pathW)
+	return append(pathW, pathV...)
+}
+
+func (b *Bipartite) IsBipartite() bool {
+	return b.isBipartite
+}
+
+// Returns the side of v, not meaningful if the graph is not bipartite
+func (b *Bipartite) Color(v int) bool {
+	return b.color[v]
+}
+
+// Returns the vertices of an odd length cycle starting and ending with the same
+// vertex, nil if the graph is bipartite.
+func (b *Bipartite) OddCycle() []int {
+	return b.cycle
+}
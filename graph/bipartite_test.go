@@ -0,0 +1,63 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBipartite(t *testing.T) {
+	// Even cycle plus a separate component
+	g := NewUGraph(7)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 0)
+	g.AddEdge(4, 5)
+	g.AddEdge(5, 6)
+
+	b := NewBipartite(g)
+	assert.Equal(t, true, b.IsBipartite())
+	assert.Nil(t, b.OddCycle())
+	for v := 0; v < g.GetNumVertices(); v++ {
+		for _, w := range g.GetNeighbors(v) {
+			assert.NotEqual(t, b.Color(v), b.Color(w))
+		}
+	}
+}
+
+func assertOddCycle(t *testing.T, g Graph, cycle []int) {
+	assert.Equal(t, cycle[0], cycle[len(cycle)-1])
+	assert.Equal(t, 1, (len(cycle)-1)%2)
+	for i := 1; i < len(cycle); i++ {
+		assert.Contains(t, g.GetNeighbors(cycle[i-1]), cycle[i])
+	}
+}
+
+func TestBipartite_OddCycle(t *testing.T) {
+	g, err := readFile("data/tinyG.txt", false)
+	assert.NoError(t, err)
+
+	b := NewBipartite(g)
+	assert.Equal(t, false, b.IsBipartite())
+	assertOddCycle(t, g, b.OddCycle())
+
+	// Pentagon with a tail
+	ug := NewUGraph(7)
+	ug.AddEdge(5, 6)
+	ug.AddEdge(6, 0)
+	for v := 0; v < 5; v++ {
+		ug.AddEdge(v, (v+1)%5)
+	}
+	b = NewBipartite(ug)
+	assert.Equal(t, false, b.IsBipartite())
+	cycle := b.OddCycle()
+	assert.Equal(t, 6, len(cycle))
+	assertOddCycle(t, ug, cycle)
+
+	ug = NewUGraph(2)
+	ug.AddEdge(0, 1)
+	ug.AddEdge(1, 1)
+	b = NewBipartite(ug)
+	assert.Equal(t, []int{1, 1}, b.OddCycle())
+}
@@ -0,0 +1,190 @@
+/*
+
+cc.go
+
+MIT License
+
+Copyright (c) 2018 rezamirz
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package graph
+
+import "github.com/rezamirz/myalgos/util"
+
+// Connected components of an undirected graph
+type CC struct {
+	count   int     // Number of connected components
+	id      []int   // Component id of v
+	members [][]int // Vertices of each component
+}
+
+func NewCC(g Graph) *CC {
+	n := g.GetNumVertices()
+	cc := &CC{
+		id: make([]int, n),
+	}
+
+	marked := make([]bool, n)
+	q := util.NewQueue()
+	for s := 0; s < n; s++ {
+		if marked[s] {
+			continue
+		}
+
+		members := []int{s}
+		marked[s] = true
+		cc.id[s] = cc.count
+		q.Push(s)
+		for q.Len() > 0 {
+			v := q.Pop().(int)
+			for _, w := range neighbors(g, v) {
+				if marked[w] {
+					continue
+				}
+				marked[w] = true
+				cc.id[w] = cc.count
+				members = append(members, w)
+				q.Push(w)
+			}
+		}
+
+		cc.members = append(cc.members, members)
+		cc.count++
+	}
+
+	return cc
+}
+
+// Returns the number of connected components
+func (cc *CC) Count() int {
+	return cc.count
+}
+
+// Returns the component id of v, ids are in [0, Count())
+func (cc *CC) ID(v int) int {
+	return cc.id[v]
+}
+
+func (cc *CC) Connected(v, w int) bool {
+	return cc.id[v] == cc.id[w]
+}
+
+// Returns the number of vertices in the component id
+func (cc *CC) Size(id int) int {
+	return len(cc.members[id])
+}
+
+// Returns the vertices of the component id
+func (cc *CC) Members(id int) []int {
+	members := make([]int, len(cc.members[id]))
+	copy(members, cc.members[id])
+	return members
+}
+
+// Undirected graph that keeps its connected components up to date with a
+// union find as the vertices and edges are added.
+type IncrementalCC struct {
+	*UGraph
+	uf util.UnionFind
+}
+
+func NewIncrementalCC(nVertices int) *IncrementalCC {
+	return &IncrementalCC{
+		UGraph: NewUGraph(nVertices),
+		uf:     util.NewUF(nVertices),
+	}
+}
+
+func (cc *IncrementalCC) AddVertex() int {
+	cc.uf.Add()
+	return cc.UGraph.AddVertex()
+}
+
+func (cc *IncrementalCC) AddEdge(v, w int) {
+	cc.UGraph.AddEdge(v, w)
+	cc.uf.Union(v, w)
+}
+
+// Removes one edge v-w, the union find can not split a component so it is
+// rebuilt from the remaining edges.
+func (cc *IncrementalCC) RemoveEdge(v, w int) bool {
+	if !cc.UGraph.RemoveEdge(v, w) {
+		return false
+	}
+
+	cc.rebuild()
+	return true
+}
+
+// Removes v like UGraph.RemoveVertex() and rebuilds the union find
+func (cc *IncrementalCC) RemoveVertex(v int) []int {
+	ids := cc.UGraph.RemoveVertex(v)
+	if ids != nil {
+		cc.rebuild()
+	}
+
+	return ids
+}
+
+func (cc *IncrementalCC) rebuild() {
+	cc.uf = util.NewUF(cc.GetNumVertices())
+	for v := 0; v < cc.GetNumVertices(); v++ {
+		for _, w := range cc.adjMap[v] {
+			cc.uf.Union(v, w)
+		}
+	}
+}
+
+// Returns the number of connected components
+func (cc *IncrementalCC) Count() int {
+	return cc.uf.Count()
+}
+
+// Returns the component id of v, the id is one of the vertices of the
+// component and it can change when an edge is added.
+func (cc *IncrementalCC) ID(v int) int {
+	id, _ := cc.uf.Find(v)
+	return id
+}
+
+func (cc *IncrementalCC) Connected(v, w int) bool {
+	connected, _ := cc.uf.Connected(v, w)
+	return connected
+}
+
+// Returns the number of vertices in the component id
+func (cc *IncrementalCC) Size(id int) int {
+	size, _ := cc.uf.Size(id)
+	return size
+}
+
+// Returns the vertices of the component id
+func (cc *IncrementalCC) Members(id int) []int {
+	members := []int{}
+	for v := 0; v < cc.GetNumVertices(); v++ {
+		if cc.ID(v) == cc.ID(id) {
+			members = append(members, v)
+		}
+	}
+
+	return members
+}
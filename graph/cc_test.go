@@ -0,0 +1,89 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCC(t *testing.T) {
+	g := NewUGraph(7)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(3, 4)
+	g.AddEdge(5, 5)
+
+	cc := NewCC(g)
+	assert.Equal(t, 4, cc.Count())
+	assert.Equal(t, true, cc.Connected(0, 2))
+	assert.Equal(t, false, cc.Connected(2, 3))
+	assert.Equal(t, false, cc.Connected(5, 6))
+
+	assert.Equal(t, 0, cc.ID(2))
+	assert.Equal(t, 3, cc.Size(cc.ID(0)))
+	assert.Equal(t, []int{0, 1, 2}, cc.Members(cc.ID(1)))
+	assert.Equal(t, []int{3, 4}, cc.Members(cc.ID(4)))
+	assert.Equal(t, 1, cc.Size(cc.ID(6)))
+}
+
+func TestIncrementalCC(t *testing.T) {
+	g, err := readFile("data/tinyG.txt", false)
+	assert.NoError(t, err)
+
+	icc := NewIncrementalCC(6)
+	assert.Equal(t, 6, icc.Count())
+	for v := 0; v < g.GetNumVertices(); v++ {
+		for _, w := range g.GetNeighbors(v) {
+			if w >= v {
+				icc.AddEdge(v, w)
+			}
+		}
+	}
+	assert.Equal(t, 8, icc.GetNumEdges())
+	assert.Equal(t, 1, icc.Count())
+
+	v := icc.AddVertex()
+	assert.Equal(t, 6, v)
+	assert.Equal(t, 2, icc.Count())
+	assert.Equal(t, false, icc.Connected(0, v))
+	assert.Equal(t, 1, icc.Size(icc.ID(v)))
+
+	icc.AddEdge(v, 3)
+	assert.Equal(t, 1, icc.Count())
+	assert.Equal(t, true, icc.Connected(0, v))
+	assert.Equal(t, 7, icc.Size(icc.ID(v)))
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6}, icc.Members(icc.ID(2)))
+
+	// The components match the static ones
+	cc := NewCC(icc)
+	assert.Equal(t, icc.Count(), cc.Count())
+}
+
+func TestIncrementalCC_Remove(t *testing.T) {
+	icc := NewIncrementalCC(4)
+	icc.AddEdge(0, 1)
+	icc.AddEdge(1, 2)
+	icc.AddEdge(2, 3)
+	assert.Equal(t, 1, icc.Count())
+
+	assert.Equal(t, true, icc.RemoveEdge(1, 2))
+	assert.Equal(t, 2, icc.Count())
+	assert.Equal(t, false, icc.Connected(1, 2))
+	assert.Equal(t, true, icc.Connected(0, 1))
+	assert.Equal(t, false, icc.RemoveEdge(1, 2))
+
+	icc.AddEdge(0, 1)
+	assert.Equal(t, true, icc.RemoveEdge(0, 1))
+	assert.Equal(t, true, icc.Connected(0, 1))
+	assert.Equal(t, true, icc.RemoveEdge(0, 1))
+	assert.Equal(t, false, icc.Connected(0, 1))
+	assert.Equal(t, 3, icc.Count())
+
+	// 3 becomes 2 and stays connected to the old 2
+	assert.Equal(t, []int{0, -1, 1, 2}, icc.RemoveVertex(1))
+	assert.Equal(t, 2, icc.Count())
+	assert.Equal(t, true, icc.Connected(1, 2))
+	assert.Equal(t, 2, icc.Size(icc.ID(2)))
+	assert.Equal(t, []int{1, 2}, icc.Members(icc.ID(1)))
+	assert.Equal(t, NewCC(icc).Count(), icc.Count())
+}
@@ -0,0 +1,196 @@
+/*
+
+csr_graph.go
+
+MIT License
+
+Copyright (c) 2018 rezamirz
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package graph
+
+import "fmt"
+
+// Immutable graph in compressed sparse row format: the neighbors of v are
+// targets[offsets[v]:offsets[v+1]]. It is built by CSRBuilder or from another
+// Graph by NewCSRGraph(), AddVertex() and AddEdge() panic.
+type CSRGraph struct {
+	offsets  []int // Start of the neighbors of v in targets, has V+1 entries
+	targets  []int // Neighbors of all the vertices
+	nEdges   int
+	directed bool
+}
+
+// Returns a CSRGraph with the same vertices, neighbors and direction as g
+func NewCSRGraph(g Graph) *CSRGraph {
+	n := g.GetNumVertices()
+	csr := &CSRGraph{
+		offsets:  make([]int, n+1),
+		targets:  make([]int, 0, g.GetNumEdges()),
+		nEdges:   g.GetNumEdges(),
+		directed: isDirected(g),
+	}
+
+	for v := 0; v < n; v++ {
+		csr.targets = append(csr.targets, neighbors(g, v)...)
+		csr.offsets[v+1] = len(csr.targets)
+	}
+
+	return csr
+}
+
+func (csr *CSRGraph) GetNumVertices() int {
+	return len(csr.offsets) - 1
+}
+
+func (csr *CSRGraph) GetNumEdges() int {
+	return csr.nEdges
+}
+
+func (csr *CSRGraph) AddVertex() int {
+	panic("AddVertex on immutable CSRGraph")
+}
+
+func (csr *CSRGraph) HasVertex(v int) bool {
+	return v >= 0 && v < csr.GetNumVertices()
+}
+
+func (csr *CSRGraph) AddEdge(v, w int) {
+	panic("AddEdge on immutable CSRGraph")
+}
+
+func (csr *CSRGraph) GetNeighbors(v int) []int {
+	neighbors := csr.Neighbors(v)
+	if len(neighbors) == 0 {
+		return nil
+	}
+
+	copyNeighbors := make([]int, len(neighbors))
+	copy(copyNeighbors, neighbors)
+	return copyNeighbors
+}
+
+// Returns the neighbors of v without copying them, the result must not be modified
+func (csr *CSRGraph) Neighbors(v int) []int {
+	start, end := csr.offsets[v], csr.offsets[v+1]
+	return csr.targets[start:end:end]
+}
+
+// Returns the number of neighbors of v
+func (csr *CSRGraph) OutDegree(v int) int {
+	return csr.offsets[v+1] - csr.offsets[v]
+}
+
+func (csr *CSRGraph) IsDirected() bool {
+	return csr.directed
+}
+
+// Returns true if the graph has the edge v->w, or v-w if it is undirected
+func (csr *CSRGraph) HasEdge(v, w int) bool {
+	return indexOf(csr.Neighbors(v), w) >= 0
+}
+
+// Returns a new graph with the direction of every edge reversed, an
+// undirected graph is returned as is since it is immutable.
+func (csr *CSRGraph) Reverse() *CSRGraph {
+	if !csr.directed {
+		return csr
+	}
+
+	b := NewCSRBuilder(csr.GetNumVertices(), true)
+	for v := 0; v < csr.GetNumVertices(); v++ {
+		for _, w := range csr.Neighbors(v) {
+			b.AddEdge(w, v)
+		}
+	}
+
+	return b.Build()
+}
+
+// Collects the edges of a CSRGraph. Like UGraph, an undirected edge is added
+// to the neighbors of both end points and a self-loop only once.
+type CSRBuilder struct {
+	nVertices int
+	directed  bool
+	from      []int
+	to        []int
+}
+
+func NewCSRBuilder(nVertices int, directed bool) *CSRBuilder {
+	return &CSRBuilder{
+		nVertices: nVertices,
+		directed:  directed,
+	}
+}
+
+// Returns the index of newly added vertex
+func (b *CSRBuilder) AddVertex() int {
+	b.nVertices++
+	return b.nVertices - 1
+}
+
+// Adds the edge v->w, or v-w if the graph is undirected. It panics if v or w
+// is not a vertex so the error shows up here rather than in Build().
+func (b *CSRBuilder) AddEdge(v, w int) {
+	if v < 0 || v >= b.nVertices || w < 0 || w >= b.nVertices {
+		panic(fmt.Sprintf("CSRBuilder.AddEdge: edge %d-%d out of range [0, %d)", v, w, b.nVertices))
+	}
+
+	b.from = append(b.from, v)
+	b.to = append(b.to, w)
+}
+
+// Returns the graph, the neighbors of each vertex are in the order the edges were added
+func (b *CSRBuilder) Build() *CSRGraph {
+	csr := &CSRGraph{
+		offsets:  make([]int, b.nVertices+1),
+		nEdges:   len(b.from),
+		directed: b.directed,
+	}
+
+	// Counting sort of the edges by their tail
+	for i, v := range b.from {
+		csr.offsets[v+1]++
+		if !b.directed && v != b.to[i] {
+			csr.offsets[b.to[i]+1]++
+		}
+	}
+
+	for v := 0; v < b.nVertices; v++ {
+		csr.offsets[v+1] += csr.offsets[v]
+	}
+
+	csr.targets = make([]int, csr.offsets[b.nVertices])
+	next := make([]int, b.nVertices)
+	copy(next, csr.offsets)
+	for i, v := range b.from {
+		w := b.to[i]
+		csr.targets[next[v]] = w
+		next[v]++
+		if !b.directed && v != w {
+			csr.targets[next[w]] = v
+			next[w]++
+		}
+	}
+
+	return csr
+}
@@ -0,0 +1,138 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCSRGraph_FromGraph(t *testing.T) {
+	for _, directed := range []bool{true, false} {
+		g, err := readFile("data/tinyG.txt", directed)
+		assert.NoError(t, err)
+
+		csr := NewCSRGraph(g)
+		assert.Equal(t, g.GetNumVertices(), csr.GetNumVertices())
+		assert.Equal(t, g.GetNumEdges(), csr.GetNumEdges())
+		assert.Equal(t, directed, csr.IsDirected())
+		for v := 0; v < g.GetNumVertices(); v++ {
+			assert.Equal(t, g.GetNeighbors(v), csr.GetNeighbors(v))
+		}
+
+		for _, searchType := range []SearchType{DepthFirstSearch, BreathFirstSearch} {
+			search := NewSearch(searchType)
+			search.DoSearch(g, 0, -1)
+			csrSearch := NewSearch(searchType)
+			csrSearch.DoSearch(csr, 0, -1)
+			assert.Equal(t, search.Count(), csrSearch.Count())
+			for v := 0; v < g.GetNumVertices(); v++ {
+				assert.Equal(t, search.PathTo(v), csrSearch.PathTo(v))
+			}
+		}
+	}
+}
+
+func TestCSRGraph_Builder(t *testing.T) {
+	b := NewCSRBuilder(3, false)
+	assert.Equal(t, 3, b.AddVertex())
+	b.AddEdge(0, 1)
+	b.AddEdge(2, 0)
+	b.AddEdge(1, 1)
+	b.AddEdge(0, 1)
+
+	ug := NewUGraph(4)
+	ug.AddEdge(0, 1)
+	ug.AddEdge(2, 0)
+	ug.AddEdge(1, 1)
+	ug.AddEdge(0, 1)
+
+	csr := b.Build()
+	assert.Equal(t, 4, csr.GetNumVertices())
+	assert.Equal(t, 4, csr.GetNumEdges())
+	assert.Equal(t, false, csr.IsDirected())
+	for v := 0; v < 4; v++ {
+		assert.Equal(t, ug.GetNeighbors(v), csr.GetNeighbors(v))
+	}
+	assert.Equal(t, 3, csr.OutDegree(0))
+	assert.Equal(t, true, csr.HasVertex(3))
+	assert.Equal(t, false, csr.HasVertex(4))
+
+	b = NewCSRBuilder(3, true)
+	b.AddEdge(2, 0)
+	b.AddEdge(0, 1)
+	b.AddEdge(2, 1)
+	csr = b.Build()
+	assert.Equal(t, []int{1}, csr.GetNeighbors(0))
+	assert.Nil(t, csr.GetNeighbors(1))
+	assert.Equal(t, []int{0, 1}, csr.Neighbors(2))
+
+	assert.Panics(t, func() { csr.AddEdge(0, 2) })
+	assert.Panics(t, func() { csr.AddVertex() })
+
+	b = NewCSRBuilder(3, false)
+	assert.Panics(t, func() { b.AddEdge(0, 5) })
+	assert.Panics(t, func() { b.AddEdge(-1, 0) })
+}
+
+func TestCSRGraph_NoAllocation(t *testing.T) {
+	const n = 1000
+	b := NewCSRBuilder(n, true)
+	for v := 0; v+1 < n; v++ {
+		b.AddEdge(v, v+1)
+	}
+	csr := b.Build()
+
+	// Appending to the neighbors must not overwrite the next vertex
+	first := csr.Neighbors(0)
+	_ = append(first, 5)
+	assert.Equal(t, []int{2}, csr.Neighbors(1))
+
+	allocs := testing.AllocsPerRun(10, func() {
+		for v := 0; v < n; v++ {
+			neighbors(csr, v)
+		}
+	})
+	assert.Equal(t, 0.0, allocs)
+}
+
+func TestCSRGraph_Reverse(t *testing.T) {
+	b := NewCSRBuilder(3, true)
+	b.AddEdge(0, 1)
+	b.AddEdge(0, 2)
+	b.AddEdge(2, 1)
+	csr := b.Build()
+
+	assert.Equal(t, true, csr.HasEdge(2, 1))
+	assert.Equal(t, false, csr.HasEdge(1, 2))
+
+	rg := csr.Reverse()
+	assert.Equal(t, 3, rg.GetNumEdges())
+	assert.Equal(t, []int{0, 2}, rg.GetNeighbors(1))
+	assert.Equal(t, []int{0}, rg.GetNeighbors(2))
+	assert.Equal(t, true, rg.HasEdge(1, 2))
+
+	b = NewCSRBuilder(2, false)
+	b.AddEdge(0, 1)
+	ug := b.Build()
+	assert.Equal(t, true, ug.HasEdge(1, 0))
+	assert.Equal(t, ug, ug.Reverse())
+}
+
+func TestCSRGraph_Algorithms(t *testing.T) {
+	g, err := readFile("data/tinyG.txt", false)
+	assert.NoError(t, err)
+	csr := NewCSRGraph(g)
+	cc, csrCC := NewCC(g), NewCC(csr)
+	assert.Equal(t, cc.Count(), csrCC.Count())
+	for v := 0; v < g.GetNumVertices(); v++ {
+		assert.Equal(t, cc.ID(v), csrCC.ID(v))
+	}
+
+	dg, err := readFile("data/tinyDG.txt", true)
+	assert.NoError(t, err)
+	csr = NewCSRGraph(dg)
+	for _, sccType := range []SCCType{KosarajuSCC, TarjanSCC} {
+		scc, csrSCC := NewSCC(dg, sccType), NewSCC(csr, sccType)
+		assert.Equal(t, scc.Components(), csrSCC.Components())
+	}
+}
@@ -0,0 +1,141 @@
+/*
+
+dijkstra.go
+
+MIT License
+
+Copyright (c) 2018 rezamirz
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package graph
+
+import (
+	"fmt"
+	"math"
+
+	algo_error "github.com/rezamirz/myalgos/error"
+	"github.com/rezamirz/myalgos/util"
+)
+
+// Single source shortest paths in an edge weighted digraph with non-negative weights
+type DijkstraSP struct {
+	s      int            // Source vertex
+	distTo []float64      // Distance of the shortest path from s to v
+	edgeTo []DirectedEdge // Last edge on the shortest path from s to v
+	pq     util.PriorityQueue
+}
+
+// Computes the shortest paths from s to every other vertex of g. Returns an
+// error wrapping NEGATIVE_WEIGHT if it encounters an edge with negative weight.
+func NewDijkstraSP(g *EdgeWeightedDigraph, s int) (*DijkstraSP, error) {
+	if !g.HasVertex(s) {
+		return nil, algo_error.INVALID_ARGUMENT
+	}
+
+	sp := &DijkstraSP{
+		s:      s,
+		distTo: make([]float64, g.GetNumVertices()),
+		edgeTo: make([]DirectedEdge, g.GetNumVertices()),
+		pq:     util.NewHashedPQ(util.MinPQ, g.GetNumVertices()),
+	}
+
+	for v := range sp.distTo {
+		sp.distTo[v] = math.Inf(1)
+	}
+	sp.distTo[s] = 0
+
+	sp.pq.Put(s, floatPriority(0))
+	for !sp.pq.IsEmpty() {
+		key, _, err := sp.pq.Dequeue()
+		if err != nil {
+			return nil, err
+		}
+
+		v := key.(int)
+		for _, e := range g.Adj(v) {
+			if err := sp.relax(e); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return sp, nil
+}
+
+func (sp *DijkstraSP) relax(e DirectedEdge) error {
+	if e.Weight() < 0 {
+		return fmt.Errorf("edge %v: %w", e, algo_error.NEGATIVE_WEIGHT)
+	}
+
+	v, w := e.From(), e.To()
+	if sp.distTo[w] > sp.distTo[v]+e.Weight() {
+		sp.distTo[w] = sp.distTo[v] + e.Weight()
+		sp.edgeTo[w] = e
+		if _, err := sp.pq.Put(w, floatPriority(sp.distTo[w])); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Returns the length of the shortest path from the source to v, +Inf if there is no path
+func (sp *DijkstraSP) DistTo(v int) float64 {
+	return sp.distTo[v]
+}
+
+func (sp *DijkstraSP) HasPathTo(v int) bool {
+	return sp.distTo[v] < math.Inf(1)
+}
+
+// Returns the edges on the shortest path from the source to v, nil if there is no path
+func (sp *DijkstraSP) PathTo(v int) []DirectedEdge {
+	if !sp.HasPathTo(v) {
+		return nil
+	}
+
+	path := []DirectedEdge{}
+	for v != sp.s {
+		e := sp.edgeTo[v]
+		path = append(path, e)
+		v = e.From()
+	}
+
+	reverseEdges(path)
+	return path
+}
+
+func reverseEdges(arr []DirectedEdge) {
+	for i, j := 0, len(arr)-1; i < j; i, j = i+1, j-1 {
+		arr[i], arr[j] = arr[j], arr[i]
+	}
+}
+
+// Maps a float64 to an int64 priority for util.HashedPQ keeping the order of the values
+func floatPriority(f float64) int64 {
+	p := int64(math.Float64bits(f))
+	if p < 0 {
+		p ^= math.MaxInt64
+	}
+
+	return p
+}
@@ -0,0 +1,62 @@
+package graph
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	algo_error "github.com/rezamirz/myalgos/error"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDijkstraSP_TinyEWD(t *testing.T) {
+	g, err := readFile("data/tinyEWD.txt", true)
+	assert.NoError(t, err)
+
+	sp, err := NewDijkstraSP(g.(*EdgeWeightedDigraph), 0)
+	assert.NoError(t, err)
+
+	expected := []float64{0, 1.05, 0.26, 0.99, 0.38, 0.73, 1.51, 0.60}
+	for v, dist := range expected {
+		assert.Equal(t, true, sp.HasPathTo(v))
+		assert.InDelta(t, dist, sp.DistTo(v), 1e-9)
+	}
+
+	assert.Equal(t, []DirectedEdge{}, sp.PathTo(0))
+	assert.Equal(t, []DirectedEdge{
+		NewDirectedEdge(0, 2, 0.26),
+		NewDirectedEdge(2, 7, 0.34),
+		NewDirectedEdge(7, 3, 0.39),
+		NewDirectedEdge(3, 6, 0.52),
+	}, sp.PathTo(6))
+}
+
+func TestDijkstraSP_Unreachable(t *testing.T) {
+	g := NewEdgeWeightedDigraph(3)
+	g.AddWeightedEdge(NewDirectedEdge(0, 1, 2))
+
+	sp, err := NewDijkstraSP(g, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, false, sp.HasPathTo(2))
+	assert.Equal(t, math.Inf(1), sp.DistTo(2))
+	assert.Nil(t, sp.PathTo(2))
+
+	_, err = NewDijkstraSP(g, 3)
+	assert.Equal(t, algo_error.INVALID_ARGUMENT, err)
+}
+
+func TestDijkstraSP_NegativeWeight(t *testing.T) {
+	g := NewEdgeWeightedDigraph(3)
+	g.AddWeightedEdge(NewDirectedEdge(0, 1, 2))
+	g.AddWeightedEdge(NewDirectedEdge(1, 2, -1))
+
+	_, err := NewDijkstraSP(g, 0)
+	assert.Equal(t, true, errors.Is(err, algo_error.NEGATIVE_WEIGHT))
+}
+
+func TestFloatPriority(t *testing.T) {
+	values := []float64{math.Inf(-1), -3.5, -1, -0.25, 0, 0.25, 1, 3.5, math.Inf(1)}
+	for i := 1; i < len(values); i++ {
+		assert.Equal(t, true, floatPriority(values[i-1]) < floatPriority(values[i]))
+	}
+}
@@ -0,0 +1,88 @@
+/*
+
+directed_cycle.go
+
+MIT License
+
+Copyright (c) 2018 rezamirz
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package graph
+
+// Finds a directed cycle in a graph with depth first search
+type DirectedCycle struct {
+	marked  []bool // is the node already marked / visited
+	onStack []bool // is the node on the recursion stack
+	edgeTo  []int  // Previous node on the path to v
+	cycle   []int  // Nodes of the cycle if there is any
+}
+
+func NewDirectedCycle(g Graph) *DirectedCycle {
+	dc := &DirectedCycle{
+		marked:  make([]bool, g.GetNumVertices()),
+		onStack: make([]bool, g.GetNumVertices()),
+		edgeTo:  make([]int, g.GetNumVertices()),
+	}
+
+	for v := 0; v < g.GetNumVertices() && dc.cycle == nil; v++ {
+		if !dc.marked[v] {
+			dc.doSearch(g, v)
+		}
+	}
+
+	return dc
+}
+
+func (dc *DirectedCycle) doSearch(g Graph, v int) {
+	dc.marked[v] = true
+	dc.onStack[v] = true
+
+	for _, w := range neighbors(g, v) {
+		if dc.cycle != nil {
+			return
+		}
+
+		if !dc.marked[w] {
+			dc.edgeTo[w] = v
+			dc.doSearch(g, w)
+		} else if dc.onStack[w] {
+			cycle := []int{w}
+			for x := v; x != w; x = dc.edgeTo[x] {
+				cycle = append(cycle, x)
+			}
+			cycle = append(cycle, w)
+			reverse(cycle)
+			dc.cycle = cycle
+		}
+	}
+
+	dc.onStack[v] = false
+}
+
+func (dc *DirectedCycle) HasCycle() bool {
+	return dc.cycle != nil
+}
+
+// Returns the nodes of the cycle starting and ending with the same node, nil if there is no cycle
+func (dc *DirectedCycle) Cycle() []int {
+	return dc.cycle
+}
@@ -0,0 +1,36 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDirectedCycle(t *testing.T) {
+	g, err := readFile("data/tinyDG.txt", true)
+	assert.NoError(t, err)
+
+	dc := NewDirectedCycle(g)
+	assert.Equal(t, true, dc.HasCycle())
+
+	cycle := dc.Cycle()
+	assert.Equal(t, cycle[0], cycle[len(cycle)-1])
+	for i := 1; i < len(cycle); i++ {
+		assert.Contains(t, g.GetNeighbors(cycle[i-1]), cycle[i])
+	}
+
+	g, err = readFile("data/tinyDAG.txt", true)
+	assert.NoError(t, err)
+	dc = NewDirectedCycle(g)
+	assert.Equal(t, false, dc.HasCycle())
+	assert.Nil(t, dc.Cycle())
+}
+
+func TestDirectedCycle_SelfLoop(t *testing.T) {
+	g := NewDGraph(3)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 1)
+
+	dc := NewDirectedCycle(g)
+	assert.Equal(t, []int{1, 1}, dc.Cycle())
+}
@@ -0,0 +1,154 @@
+/*
+
+dot.go
+
+MIT License
+
+Copyright (c) 2018 rezamirz
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package graph
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Colors of the components in the output of WriteDot()
+var dotColors = []string{
+	"lightblue", "lightpink", "palegreen", "khaki", "plum",
+	"lightsalmon", "paleturquoise", "wheat", "thistle", "lightgray",
+}
+
+// Options of WriteDot(), the zero value writes the plain graph
+type DotOptions struct {
+	Name       string // Name of the graph, G if empty
+	Path       []int  // Vertices of a path to highlight, such as the result of PathTo()
+	Components []int  // Component id of every vertex to color them by, such as the ID() of NewCC()
+	Weights    bool   // Label the edges of the weighted graph types with their weight
+}
+
+// WriteDot stores g in the Graphviz DOT language. A directed graph becomes a
+// digraph, an undirected one a graph with every edge written once.
+func WriteDot(w io.Writer, g Graph, opts *DotOptions) error {
+	if opts == nil {
+		opts = &DotOptions{}
+	}
+
+	name := opts.Name
+	if name == "" {
+		name = "G"
+	}
+
+	directed := isDirected(g)
+	kind, edgeOp := "graph", "--"
+	if directed {
+		kind, edgeOp = "digraph", "->"
+	}
+
+	onPath := map[int]bool{}
+	pathEdges := map[[2]int]bool{}
+	for i, v := range opts.Path {
+		onPath[v] = true
+		if i > 0 {
+			u := opts.Path[i-1]
+			pathEdges[[2]int{u, v}] = true
+			if !directed {
+				pathEdges[[2]int{v, u}] = true
+			}
+		}
+	}
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "%s %s {\n", kind, dotID(name))
+	for v := 0; v < g.GetNumVertices(); v++ {
+		attrs := []string{}
+		if v < len(opts.Components) && opts.Components[v] >= 0 {
+			color := dotColors[opts.Components[v]%len(dotColors)]
+			attrs = append(attrs, "style=filled", "fillcolor="+color)
+		}
+		if onPath[v] {
+			attrs = append(attrs, "color=red", "penwidth=2")
+		}
+		fmt.Fprintf(bw, "\t%d%s;\n", v, dotAttrs(attrs))
+	}
+
+	forEachEdge(g, func(v, w int, weight float64, weighted bool) {
+		attrs := []string{}
+		if weighted && opts.Weights {
+			attrs = append(attrs, fmt.Sprintf("label=%q", formatWeight(weight)))
+		}
+		if pathEdges[[2]int{v, w}] {
+			attrs = append(attrs, "color=red", "penwidth=2")
+		}
+		fmt.Fprintf(bw, "\t%d %s %d%s;\n", v, edgeOp, w, dotAttrs(attrs))
+	})
+	fmt.Fprintln(bw, "}")
+
+	return bw.Flush()
+}
+
+// Returns name as is if it is a valid DOT identifier, otherwise quoted
+func dotID(name string) string {
+	for i, r := range name {
+		if !(r == '_' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || i > 0 && r >= '0' && r <= '9') {
+			return fmt.Sprintf("%q", name)
+		}
+	}
+
+	return name
+}
+
+func dotAttrs(attrs []string) string {
+	if len(attrs) == 0 {
+		return ""
+	}
+
+	return " [" + strings.Join(attrs, ", ") + "]"
+}
+
+// Calls fn for every edge of g, the edges of undirected graphs once. The
+// weight is only meaningful for the weighted graph types.
+func forEachEdge(g Graph, fn func(v, w int, weight float64, weighted bool)) {
+	switch wg := g.(type) {
+	case *EdgeWeightedDigraph:
+		for _, e := range wg.Edges() {
+			fn(e.From(), e.To(), e.Weight(), true)
+		}
+	case *EdgeWeightedGraph:
+		for _, e := range wg.Edges() {
+			v := e.Either()
+			fn(v, e.Other(v), e.Weight(), true)
+		}
+	default:
+		directed := isDirected(g)
+		for v := 0; v < g.GetNumVertices(); v++ {
+			for _, w := range neighbors(g, v) {
+				if directed || w >= v {
+					fn(v, w, 1, false)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package graph
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteDot(t *testing.T) {
+	g := NewDGraph(3)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+
+	var buf bytes.Buffer
+	assert.NoError(t, WriteDot(&buf, g, nil))
+	assert.Equal(t, "digraph G {\n\t0;\n\t1;\n\t2;\n\t0 -> 1;\n\t1 -> 2;\n}\n", buf.String())
+}
+
+func TestWriteDot_Path(t *testing.T) {
+	g, err := readFile("data/tinyG.txt", false)
+	assert.NoError(t, err)
+
+	bfs := NewSearch(BreathFirstSearch)
+	bfs.DoSearch(g, 3, 0)
+	assert.Equal(t, []int{3, 2, 0}, bfs.PathTo(0))
+
+	var buf bytes.Buffer
+	assert.NoError(t, WriteDot(&buf, g, &DotOptions{Name: "tiny G", Path: bfs.PathTo(0)}))
+	out := buf.String()
+	assert.True(t, strings.HasPrefix(out, "graph \"tiny G\" {\n"))
+	assert.Contains(t, out, "\t3 [color=red, penwidth=2];\n")
+	assert.Contains(t, out, "\t1;\n")
+	assert.Contains(t, out, "\t3 -- 5;\n")
+	// The edges are written once from the smaller vertex
+	assert.Contains(t, out, "\t0 -- 2 [color=red, penwidth=2];\n")
+	assert.Contains(t, out, "\t2 -- 3 [color=red, penwidth=2];\n")
+	assert.Contains(t, out, "\t0 -- 1;\n")
+	assert.Equal(t, g.GetNumEdges(), strings.Count(out, " -- "))
+}
+
+func TestWriteDot_ComponentsAndWeights(t *testing.T) {
+	g := NewEdgeWeightedGraph(4)
+	g.AddWeightedEdge(NewEdge(0, 1, 0.5))
+	g.AddWeightedEdge(NewEdge(2, 3, 1.25))
+	cc := NewCC(g)
+	components := make([]int, g.GetNumVertices())
+	for v := range components {
+		components[v] = cc.ID(v)
+	}
+
+	var buf bytes.Buffer
+	assert.NoError(t, WriteDot(&buf, g, &DotOptions{Components: components, Weights: true}))
+	out := buf.String()
+	assert.Contains(t, out, "\t0 [style=filled, fillcolor=lightblue];\n")
+	assert.Contains(t, out, "\t3 [style=filled, fillcolor=lightpink];\n")
+	assert.Contains(t, out, "\t0 -- 1 [label=\"0.5\"];\n")
+	assert.Contains(t, out, "\t2 -- 3 [label=\"1.25\"];\n")
+
+	// Without Weights the edges have no label
+	buf.Reset()
+	assert.NoError(t, WriteDot(&buf, g.ToDigraph(), nil))
+	assert.Contains(t, buf.String(), "\t1 -> 0;\n")
+	assert.NotContains(t, buf.String(), "label")
+}
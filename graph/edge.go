@@ -0,0 +1,101 @@
+/*
+
+edge.go
+
+MIT License
+
+Copyright (c) 2018 rezamirz
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package graph
+
+import "fmt"
+
+// Weighted undirected edge
+type Edge struct {
+	v      int
+	w      int
+	weight float64
+}
+
+func NewEdge(v, w int, weight float64) Edge {
+	return Edge{
+		v:      v,
+		w:      w,
+		weight: weight,
+	}
+}
+
+// Returns one of the end points of the edge
+func (e Edge) Either() int {
+	return e.v
+}
+
+// Returns the end point of the edge that is not v
+func (e Edge) Other(v int) int {
+	if v == e.v {
+		return e.w
+	}
+
+	return e.v
+}
+
+func (e Edge) Weight() float64 {
+	return e.weight
+}
+
+func (e Edge) String() string {
+	return fmt.Sprintf("%d-%d %.5f", e.v, e.w, e.weight)
+}
+
+// Weighted directed edge
+type DirectedEdge struct {
+	from   int
+	to     int
+	weight float64
+}
+
+func NewDirectedEdge(from, to int, weight float64) DirectedEdge {
+	return DirectedEdge{
+		from:   from,
+		to:     to,
+		weight: weight,
+	}
+}
+
+// Returns the tail vertex of the edge
+func (e DirectedEdge) From() int {
+	return e.from
+}
+
+// Returns the head vertex of the edge
+func (e DirectedEdge) To() int {
+	return e.to
+}
+
+func (e DirectedEdge) Weight() float64 {
+	return e.weight
+}
+
+func (e DirectedEdge) String() string {
+	return fmt.Sprintf("%d->%d %.5f", e.from, e.to, e.weight)
+}
@@ -0,0 +1,198 @@
+/*
+
+euler.go
+
+MIT License
+
+Copyright (c) 2018 rezamirz
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package graph
+
+import (
+	"fmt"
+
+	algo_error "github.com/rezamirz/myalgos/error"
+)
+
+// Returns the vertices of a walk that uses every edge of g exactly once,
+// starting and ending at the same vertex. g is treated as undirected if it
+// reports so with IsDirected(). Returns an error wrapping NOT_EULERIAN that
+// explains why there is no such walk.
+func EulerianCircuit(g Graph) ([]int, error) {
+	return eulerian(g, true)
+}
+
+// Returns the vertices of a walk that uses every edge of g exactly once. g is
+// treated as undirected if it reports so with IsDirected(). Returns an error
+// wrapping NOT_EULERIAN that explains why there is no such walk.
+func EulerianPath(g Graph) ([]int, error) {
+	return eulerian(g, false)
+}
+
+func eulerian(g Graph, circuit bool) ([]int, error) {
+	n := g.GetNumVertices()
+	adj := make([][]int, n)
+	for v := 0; v < n; v++ {
+		adj[v] = neighbors(g, v)
+	}
+
+	var start int
+	var err error
+	if isDirected(g) {
+		start, err = directedEulerianStart(adj, circuit)
+	} else {
+		start, err = undirectedEulerianStart(adj, circuit)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if start < 0 {
+		// No edges
+		return []int{}, nil
+	}
+
+	path := hierholzer(adj, start, !isDirected(g))
+	if len(path) != g.GetNumEdges()+1 {
+		return nil, fmt.Errorf("edges are not connected, %d of %d edges reachable from %d: %w",
+			len(path)-1, g.GetNumEdges(), start, algo_error.NOT_EULERIAN)
+	}
+
+	return path, nil
+}
+
+// Returns the vertex to start from, -1 if there are no edges
+func directedEulerianStart(adj [][]int, circuit bool) (int, error) {
+	balance := make([]int, len(adj))
+	for v := range adj {
+		balance[v] += len(adj[v])
+		for _, w := range adj[v] {
+			balance[w]--
+		}
+	}
+
+	start := -1
+	end := -1
+	for v, b := range balance {
+		switch {
+		case b == 0:
+			continue
+		case b == 1 && start < 0 && !circuit:
+			start = v
+		case b == -1 && end < 0 && !circuit:
+			end = v
+		default:
+			return 0, fmt.Errorf("vertex %d has out-degree - in-degree = %d: %w", v, b, algo_error.NOT_EULERIAN)
+		}
+	}
+
+	if start >= 0 {
+		return start, nil
+	}
+
+	for v := range adj {
+		if len(adj[v]) > 0 {
+			return v, nil
+		}
+	}
+
+	return -1, nil
+}
+
+// Returns the vertex to start from, -1 if there are no edges
+func undirectedEulerianStart(adj [][]int, circuit bool) (int, error) {
+	odd := []int{}
+	start := -1
+	for v := range adj {
+		degree := 0
+		for _, w := range adj[v] {
+			if w == v {
+				degree += 2
+			} else {
+				degree++
+			}
+		}
+
+		if degree%2 == 1 {
+			odd = append(odd, v)
+		}
+		if degree > 0 && start < 0 {
+			start = v
+		}
+	}
+
+	if len(odd) > 0 && (circuit || len(odd) > 2) {
+		return 0, fmt.Errorf("vertices %v have odd degree: %w", odd, algo_error.NOT_EULERIAN)
+	}
+
+	if len(odd) > 0 {
+		return odd[0], nil
+	}
+
+	return start, nil
+}
+
+// Hierholzer's algorithm with an explicit stack. An undirected edge appears in
+// the adjacency lists of both end points, the remaining count of each vertex
+// pair makes sure it is used once.
+func hierholzer(adj [][]int, start int, undirected bool) []int {
+	next := make([]int, len(adj))
+	remaining := map[[2]int]int{}
+	if undirected {
+		for v := range adj {
+			for _, w := range adj[v] {
+				if w >= v {
+					remaining[[2]int{v, w}]++
+				}
+			}
+		}
+	}
+
+	path := []int{}
+	stack := []int{start}
+	for len(stack) > 0 {
+		v := stack[len(stack)-1]
+		if next[v] == len(adj[v]) {
+			stack = stack[:len(stack)-1]
+			path = append(path, v)
+			continue
+		}
+
+		w := adj[v][next[v]]
+		next[v]++
+		if undirected {
+			key := [2]int{v, w}
+			if w < v {
+				key = [2]int{w, v}
+			}
+			if remaining[key] == 0 {
+				continue
+			}
+			remaining[key]--
+		}
+		stack = append(stack, w)
+	}
+
+	reverse(path)
+	return path
+}
@@ -0,0 +1,108 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+
+	algo_error "github.com/rezamirz/myalgos/error"
+	"github.com/stretchr/testify/assert"
+)
+
+// Checks that the walk uses every edge of g exactly once
+func assertEulerian(t *testing.T, g Graph, path []int) {
+	remaining := map[[2]int]int{}
+	for v := 0; v < g.GetNumVertices(); v++ {
+		for _, w := range g.GetNeighbors(v) {
+			if isDirected(g) || w >= v {
+				remaining[[2]int{v, w}]++
+			}
+		}
+	}
+
+	assert.Equal(t, g.GetNumEdges()+1, len(path))
+	for i := 1; i < len(path); i++ {
+		key := [2]int{path[i-1], path[i]}
+		if !isDirected(g) && key[0] > key[1] {
+			key = [2]int{key[1], key[0]}
+		}
+		assert.Equal(t, true, remaining[key] > 0, key)
+		remaining[key]--
+	}
+}
+
+func TestEulerian_Directed(t *testing.T) {
+	g := NewDGraph(4)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 0)
+	g.AddEdge(0, 3)
+	g.AddEdge(3, 0)
+	g.AddEdge(2, 2)
+
+	circuit, err := EulerianCircuit(g)
+	assert.NoError(t, err)
+	assertEulerian(t, g, circuit)
+	assert.Equal(t, circuit[0], circuit[len(circuit)-1])
+
+	g.AddEdge(1, 3)
+	_, err = EulerianCircuit(g)
+	assert.Equal(t, true, errors.Is(err, algo_error.NOT_EULERIAN))
+
+	path, err := EulerianPath(g)
+	assert.NoError(t, err)
+	assertEulerian(t, g, path)
+	assert.Equal(t, 1, path[0])
+	assert.Equal(t, 3, path[len(path)-1])
+
+	g.AddEdge(1, 3)
+	_, err = EulerianPath(g)
+	assert.Equal(t, true, errors.Is(err, algo_error.NOT_EULERIAN))
+}
+
+func TestEulerian_Undirected(t *testing.T) {
+	g, err := readFile("data/tinyG.txt", false)
+	assert.NoError(t, err)
+
+	// Vertices 0 and 3 have odd degree
+	_, err = EulerianCircuit(g)
+	assert.Equal(t, true, errors.Is(err, algo_error.NOT_EULERIAN))
+
+	path, err := EulerianPath(g)
+	assert.NoError(t, err)
+	assertEulerian(t, g, path)
+	assert.ElementsMatch(t, []int{0, 3}, []int{path[0], path[len(path)-1]})
+
+	g.AddEdge(0, 3)
+	g.AddEdge(4, 4)
+	circuit, err := EulerianCircuit(g)
+	assert.NoError(t, err)
+	assertEulerian(t, g, circuit)
+
+	g.AddEdge(1, 4)
+	g.AddEdge(2, 5)
+	_, err = EulerianPath(g)
+	assert.Equal(t, true, errors.Is(err, algo_error.NOT_EULERIAN))
+}
+
+func TestEulerian_Disconnected(t *testing.T) {
+	g := NewUGraph(6)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 0)
+	g.AddEdge(3, 4)
+	g.AddEdge(4, 3)
+
+	_, err := EulerianCircuit(g)
+	assert.Equal(t, true, errors.Is(err, algo_error.NOT_EULERIAN))
+
+	// Isolated vertices do not matter
+	g = NewUGraph(6)
+	g.AddEdge(3, 4)
+	path, err := EulerianPath(g)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{3, 4}, path)
+
+	path, err = EulerianCircuit(NewDGraph(3))
+	assert.NoError(t, err)
+	assert.Equal(t, []int{}, path)
+}
@@ -0,0 +1,306 @@
+/*
+
+flow_network.go
+
+MIT License
+
+Copyright (c) 2018 rezamirz
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package graph
+
+import "fmt"
+
+// Flows and capacities closer than this are considered equal
+const flowEpsilon = 1e-11
+
+// Capacitated edge with a flow in a FlowNetwork
+type FlowEdge struct {
+	v        int // Tail vertex
+	w        int // Head vertex
+	capacity float64
+	flow     float64
+	cost     float64 // Cost of a unit of flow, used by MinCostFlow
+}
+
+func NewFlowEdge(v, w int, capacity float64) *FlowEdge {
+	return &FlowEdge{
+		v:        v,
+		w:        w,
+		capacity: capacity,
+	}
+}
+
+func NewCostFlowEdge(v, w int, capacity, cost float64) *FlowEdge {
+	return &FlowEdge{
+		v:        v,
+		w:        w,
+		capacity: capacity,
+		cost:     cost,
+	}
+}
+
+// Returns the tail vertex of the edge
+func (e *FlowEdge) From() int {
+	return e.v
+}
+
+// Returns the head vertex of the edge
+func (e *FlowEdge) To() int {
+	return e.w
+}
+
+// Returns the end point of the edge that is not v
+func (e *FlowEdge) Other(v int) int {
+	if v == e.v {
+		return e.w
+	}
+
+	return e.v
+}
+
+func (e *FlowEdge) Capacity() float64 {
+	return e.capacity
+}
+
+func (e *FlowEdge) Flow() float64 {
+	return e.flow
+}
+
+func (e *FlowEdge) Cost() float64 {
+	return e.cost
+}
+
+// Returns the flow that can still be sent toward v in the residual network,
+// i.e. the unused capacity forward and the flow backward.
+func (e *FlowEdge) ResidualCapacityTo(v int) float64 {
+	if v == e.w {
+		return e.capacity - e.flow
+	}
+
+	return e.flow
+}
+
+// Returns the cost of sending a unit of flow toward v in the residual network
+func (e *FlowEdge) ResidualCostTo(v int) float64 {
+	if v == e.w {
+		return e.cost
+	}
+
+	return -e.cost
+}
+
+// Sends delta more flow toward v in the residual network
+func (e *FlowEdge) addResidualFlowTo(v int, delta float64) {
+	if v == e.w {
+		e.flow += delta
+	} else {
+		e.flow -= delta
+	}
+
+	// Round off the floating point errors
+	if e.flow < flowEpsilon {
+		e.flow = 0
+	}
+	if e.capacity-e.flow < flowEpsilon {
+		e.flow = e.capacity
+	}
+}
+
+func (e *FlowEdge) String() string {
+	return fmt.Sprintf("%d->%d %.5f/%.5f", e.v, e.w, e.flow, e.capacity)
+}
+
+// Directed network of capacitated edges, every edge is stored in the
+// adjacency lists of both end points to walk the residual network.
+type FlowNetwork struct {
+	// Adjacency map of the graph nodes
+	adjMap map[int][]*FlowEdge
+
+	nVertices int
+	nEdges    int
+}
+
+func NewFlowNetwork(nVertices int) *FlowNetwork {
+	net := &FlowNetwork{
+		adjMap:    map[int][]*FlowEdge{},
+		nVertices: nVertices,
+	}
+
+	return net
+}
+
+func (net *FlowNetwork) GetNumVertices() int {
+	return net.nVertices
+}
+
+func (net *FlowNetwork) GetNumEdges() int {
+	return net.nEdges
+}
+
+func (net *FlowNetwork) AddVertex() int {
+	net.nVertices++
+	return net.nVertices - 1
+}
+
+func (net *FlowNetwork) HasVertex(v int) bool {
+	return v >= 0 && v < net.nVertices
+}
+
+// Adds the edge v->w with capacity 1
+func (net *FlowNetwork) AddEdge(v, w int) {
+	net.AddFlowEdge(NewFlowEdge(v, w, 1))
+}
+
+// Adds e to the network, the flow of e is updated by the max-flow solvers
+func (net *FlowNetwork) AddFlowEdge(e *FlowEdge) {
+	net.adjMap[e.v] = append(net.adjMap[e.v], e)
+	if e.v != e.w {
+		net.adjMap[e.w] = append(net.adjMap[e.w], e)
+	}
+	net.nEdges++
+}
+
+// Returns the heads of the edges pointing from v
+func (net *FlowNetwork) GetNeighbors(v int) []int {
+	neighbors := []int{}
+	for _, e := range net.adjMap[v] {
+		if e.v == v {
+			neighbors = append(neighbors, e.w)
+		}
+	}
+
+	if len(neighbors) == 0 {
+		return nil
+	}
+	return neighbors
+}
+
+// Returns the edges pointing from and to v
+func (net *FlowNetwork) Adj(v int) []*FlowEdge {
+	edges, ok := net.adjMap[v]
+	if !ok {
+		return nil
+	}
+
+	copyEdges := make([]*FlowEdge, len(edges))
+	copy(copyEdges, edges)
+	return copyEdges
+}
+
+// Returns all the edges of the network
+func (net *FlowNetwork) Edges() []*FlowEdge {
+	edges := make([]*FlowEdge, 0, net.nEdges)
+	for v := 0; v < net.nVertices; v++ {
+		for _, e := range net.adjMap[v] {
+			if e.v == v {
+				edges = append(edges, e)
+			}
+		}
+	}
+
+	return edges
+}
+
+func (net *FlowNetwork) IsDirected() bool {
+	return true
+}
+
+// Returns true if the network has an edge v->w
+func (net *FlowNetwork) HasEdge(v, w int) bool {
+	return flowEdgeIndex(net.adjMap[v], v, w) >= 0
+}
+
+// Removes one edge v->w from the adjacency lists of both end points, returns
+// false if there is no such edge
+func (net *FlowNetwork) RemoveEdge(v, w int) bool {
+	i := flowEdgeIndex(net.adjMap[v], v, w)
+	if i < 0 {
+		return false
+	}
+
+	e := net.adjMap[v][i]
+	net.adjMap[v] = append(net.adjMap[v][:i], net.adjMap[v][i+1:]...)
+	if v != w {
+		for j, f := range net.adjMap[w] {
+			if f == e {
+				net.adjMap[w] = append(net.adjMap[w][:j], net.adjMap[w][j+1:]...)
+				break
+			}
+		}
+	}
+	net.nEdges--
+	return true
+}
+
+// Removes v and all the edges incident to it, the vertices after v move down
+// by one and the remaining edges are renumbered in place. Returns the new id
+// of every old vertex, -1 for v, or nil if the network has no vertex v.
+func (net *FlowNetwork) RemoveVertex(v int) []int {
+	if !net.HasVertex(v) {
+		return nil
+	}
+
+	ids := removedVertexIDs(net.nVertices, v)
+	edges := net.Edges()
+	net.adjMap = map[int][]*FlowEdge{}
+	net.nVertices--
+	net.nEdges = 0
+	for _, e := range edges {
+		if e.v != v && e.w != v {
+			e.v, e.w = ids[e.v], ids[e.w]
+			net.AddFlowEdge(e)
+		}
+	}
+
+	return ids
+}
+
+// Returns a new network with the direction of every edge reversed, the edges
+// keep their capacity, cost and flow
+func (net *FlowNetwork) Reverse() *FlowNetwork {
+	rn := NewFlowNetwork(net.nVertices)
+	for _, e := range net.Edges() {
+		re := NewCostFlowEdge(e.w, e.v, e.capacity, e.cost)
+		re.flow = e.flow
+		rn.AddFlowEdge(re)
+	}
+
+	return rn
+}
+
+// Returns the index of the first edge v->w in edges, -1 if there is none
+func flowEdgeIndex(edges []*FlowEdge, v, w int) int {
+	for i, e := range edges {
+		if e.v == v && e.w == w {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func (net *FlowNetwork) resetFlow() {
+	for _, e := range net.Edges() {
+		e.flow = 0
+	}
+}
@@ -0,0 +1,146 @@
+/*
+
+floyd_warshall.go
+
+MIT License
+
+Copyright (c) 2018 rezamirz
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package graph
+
+import "math"
+
+// All pairs shortest paths with Floyd-Warshall in O(V^3) time and O(V^2)
+// space, suitable for small dense graphs. The weights can be negative.
+type FloydWarshall struct {
+	dist    [][]float64      // Length of the shortest path from u to v
+	edgeTo  [][]DirectedEdge // Last edge on the shortest path from u to v
+	hasEdge [][]bool         // Is edgeTo[u][v] set
+	cycle   []DirectedEdge   // Negative cycle if there is any
+}
+
+func NewFloydWarshall(g *EdgeWeightedDigraph) *FloydWarshall {
+	n := g.GetNumVertices()
+	fw := &FloydWarshall{
+		dist:    make([][]float64, n),
+		edgeTo:  make([][]DirectedEdge, n),
+		hasEdge: make([][]bool, n),
+	}
+
+	for u := 0; u < n; u++ {
+		fw.dist[u] = make([]float64, n)
+		fw.edgeTo[u] = make([]DirectedEdge, n)
+		fw.hasEdge[u] = make([]bool, n)
+		for v := range fw.dist[u] {
+			fw.dist[u][v] = math.Inf(1)
+		}
+		fw.dist[u][u] = 0
+	}
+
+	// The lightest of the parallel edges
+	for _, e := range g.Edges() {
+		if e.Weight() < fw.dist[e.From()][e.To()] {
+			fw.dist[e.From()][e.To()] = e.Weight()
+			fw.edgeTo[e.From()][e.To()] = e
+			fw.hasEdge[e.From()][e.To()] = true
+		}
+	}
+
+	for i := 0; i < n; i++ {
+		for u := 0; u < n; u++ {
+			if !fw.hasEdge[u][i] {
+				continue
+			}
+			for v := 0; v < n; v++ {
+				if fw.dist[u][i]+fw.dist[i][v] < fw.dist[u][v] {
+					fw.dist[u][v] = fw.dist[u][i] + fw.dist[i][v]
+					fw.edgeTo[u][v] = fw.edgeTo[i][v]
+					fw.hasEdge[u][v] = true
+				}
+			}
+
+			if fw.dist[u][u] < 0 {
+				fw.findNegativeCycle(u)
+				return fw
+			}
+		}
+	}
+
+	return fw
+}
+
+// Follows the last edges of the paths from u back from u until a vertex repeats
+func (fw *FloydWarshall) findNegativeCycle(u int) {
+	position := map[int]int{}
+	edges := []DirectedEdge{}
+	for v := u; ; {
+		if i, ok := position[v]; ok {
+			cycle := edges[i:]
+			reverseEdges(cycle)
+			fw.cycle = cycle
+			return
+		}
+
+		position[v] = len(edges)
+		e := fw.edgeTo[u][v]
+		edges = append(edges, e)
+		v = e.From()
+	}
+}
+
+// Returns the length of the shortest path from u to v, +Inf if there is no
+// path. The result is not meaningful if there is a negative cycle.
+func (fw *FloydWarshall) Dist(u, v int) float64 {
+	return fw.dist[u][v]
+}
+
+func (fw *FloydWarshall) HasPath(u, v int) bool {
+	return fw.dist[u][v] < math.Inf(1)
+}
+
+// Returns the edges on the shortest path from u to v, nil if there is no path
+// or there is a negative cycle.
+func (fw *FloydWarshall) Path(u, v int) []DirectedEdge {
+	if fw.HasNegativeCycle() || !fw.HasPath(u, v) {
+		return nil
+	}
+
+	path := []DirectedEdge{}
+	for v != u {
+		e := fw.edgeTo[u][v]
+		path = append(path, e)
+		v = e.From()
+	}
+
+	reverseEdges(path)
+	return path
+}
+
+func (fw *FloydWarshall) HasNegativeCycle() bool {
+	return fw.cycle != nil
+}
+
+// Returns the edges of a negative cycle, nil if there is none
+func (fw *FloydWarshall) NegativeCycle() []DirectedEdge {
+	return fw.cycle
+}
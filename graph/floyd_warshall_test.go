@@ -0,0 +1,83 @@
+package graph
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func pathWeight(path []DirectedEdge) float64 {
+	weight := 0.0
+	for _, e := range path {
+		weight += e.Weight()
+	}
+
+	return weight
+}
+
+func TestFloydWarshall(t *testing.T) {
+	g, err := readFile("data/tinyEWDn.txt", true)
+	assert.NoError(t, err)
+	ewd := g.(*EdgeWeightedDigraph)
+
+	fw := NewFloydWarshall(ewd)
+	assert.Equal(t, false, fw.HasNegativeCycle())
+	assert.Nil(t, fw.NegativeCycle())
+
+	for u := 0; u < ewd.GetNumVertices(); u++ {
+		sp, err := NewBellmanFordSP(ewd, u)
+		assert.NoError(t, err)
+		for v := 0; v < ewd.GetNumVertices(); v++ {
+			assert.Equal(t, sp.HasPathTo(v), fw.HasPath(u, v))
+			assert.InDelta(t, sp.DistTo(v), fw.Dist(u, v), 1e-9)
+
+			path := fw.Path(u, v)
+			if !fw.HasPath(u, v) {
+				assert.Nil(t, path)
+				continue
+			}
+			assert.InDelta(t, fw.Dist(u, v), pathWeight(path), 1e-9)
+			for i := 1; i < len(path); i++ {
+				assert.Equal(t, path[i-1].To(), path[i].From())
+			}
+		}
+	}
+
+	assert.Equal(t, []DirectedEdge{}, fw.Path(3, 3))
+}
+
+func TestFloydWarshall_Unreachable(t *testing.T) {
+	g := NewEdgeWeightedDigraph(3)
+	g.AddWeightedEdge(NewDirectedEdge(0, 1, 2))
+	g.AddWeightedEdge(NewDirectedEdge(0, 1, 1))
+
+	fw := NewFloydWarshall(g)
+	assert.Equal(t, 1.0, fw.Dist(0, 1))
+	assert.Equal(t, []DirectedEdge{NewDirectedEdge(0, 1, 1)}, fw.Path(0, 1))
+	assert.Equal(t, false, fw.HasPath(1, 0))
+	assert.Equal(t, math.Inf(1), fw.Dist(0, 2))
+	assert.Nil(t, fw.Path(0, 2))
+}
+
+func TestFloydWarshall_NegativeCycle(t *testing.T) {
+	g, err := readFile("data/tinyEWDnc.txt", true)
+	assert.NoError(t, err)
+
+	fw := NewFloydWarshall(g.(*EdgeWeightedDigraph))
+	assert.Equal(t, true, fw.HasNegativeCycle())
+	assert.Nil(t, fw.Path(0, 1))
+
+	cycle := fw.NegativeCycle()
+	assert.Equal(t, true, pathWeight(cycle) < 0)
+	for i, e := range cycle {
+		assert.Equal(t, e.To(), cycle[(i+1)%len(cycle)].From())
+	}
+
+	// A negative undirected edge is a negative cycle
+	ug := NewEdgeWeightedGraph(2)
+	ug.AddWeightedEdge(NewEdge(0, 1, -1))
+	fw = NewFloydWarshall(ug.ToDigraph())
+	assert.Equal(t, true, fw.HasNegativeCycle())
+	assert.Equal(t, 2, len(fw.NegativeCycle()))
+}
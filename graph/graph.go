@@ -74,13 +74,16 @@ func (dg *DGraph) GetNumEdges() int {
 	return dg.nEdges
 }
 
+// Returns the index of the newly added vertex, which is the old number of
+// vertices since the vertices are numbered from 0
 func (dg *DGraph) AddVertex() int {
 	dg.nVertices++
-	return dg.nVertices
+	return dg.nVertices - 1
 }
 
+// Returns true if v is in [0, GetNumVertices())
 func (dg *DGraph) HasVertex(v int) bool {
-	return v > 0 && v < dg.nVertices
+	return v >= 0 && v < dg.nVertices
 }
 
 func (dg *DGraph) AddEdge(v, w int) {
@@ -101,3 +104,272 @@ func (dg *DGraph) GetNeighbors(v int) []int {
 	copy(copyNeighbors, neighbors)
 	return copyNeighbors
 }
+
+// Returns true since the edges of DGraph have a direction
+func (dg *DGraph) IsDirected() bool {
+	return true
+}
+
+// Returns true if the graph has the edge v->w
+func (dg *DGraph) HasEdge(v, w int) bool {
+	return indexOf(dg.adjMap[v], w) >= 0
+}
+
+// Removes one edge v->w, returns false if there is no such edge
+func (dg *DGraph) RemoveEdge(v, w int) bool {
+	neighbors, ok := removeNeighbor(dg.adjMap[v], w)
+	if !ok {
+		return false
+	}
+
+	dg.adjMap[v] = neighbors
+	dg.nEdges--
+	return true
+}
+
+// Removes v and all the edges incident to it, the vertices after v move down
+// by one. Returns the new id of every old vertex, -1 for v, or nil if the
+// graph has no vertex v.
+func (dg *DGraph) RemoveVertex(v int) []int {
+	if !dg.HasVertex(v) {
+		return nil
+	}
+
+	ids := removedVertexIDs(dg.nVertices, v)
+	adjMap := map[int][]int{}
+	nEdges := 0
+	for u := 0; u < dg.nVertices; u++ {
+		if u == v {
+			continue
+		}
+		for _, w := range dg.adjMap[u] {
+			if w != v {
+				adjMap[ids[u]] = append(adjMap[ids[u]], ids[w])
+				nEdges++
+			}
+		}
+	}
+
+	dg.adjMap = adjMap
+	dg.nVertices--
+	dg.nEdges = nEdges
+	return ids
+}
+
+// Returns a new graph with the direction of every edge reversed
+func (dg *DGraph) Reverse() *DGraph {
+	rg := &DGraph{
+		adjMap:    map[int][]int{},
+		nVertices: dg.nVertices,
+		nEdges:    dg.nEdges,
+	}
+	for v := 0; v < dg.nVertices; v++ {
+		for _, w := range dg.adjMap[v] {
+			rg.adjMap[w] = append(rg.adjMap[w], v)
+		}
+	}
+
+	return rg
+}
+
+// Undirected graph
+//
+// Every edge v-w is stored in the adjacency lists of both v and w, except a
+// self-loop v-v which is stored once in the adjacency list of v. Parallel
+// edges are kept and each one is counted as a separate edge.
+type UGraph struct {
+	// Adjacency map of the graph nodes
+	adjMap map[int][]int
+
+	nVertices int
+	nEdges    int
+}
+
+func NewUGraph(nVertices int) *UGraph {
+	g := &UGraph{
+		adjMap:    map[int][]int{},
+		nVertices: nVertices,
+	}
+
+	return g
+}
+
+func (ug *UGraph) GetNumVertices() int {
+	return ug.nVertices
+}
+
+func (ug *UGraph) GetNumEdges() int {
+	return ug.nEdges
+}
+
+func (ug *UGraph) AddVertex() int {
+	ug.nVertices++
+	return ug.nVertices - 1
+}
+
+func (ug *UGraph) HasVertex(v int) bool {
+	return v >= 0 && v < ug.nVertices
+}
+
+// Adds the undirected edge v-w, it is counted once in GetNumEdges()
+func (ug *UGraph) AddEdge(v, w int) {
+	ug.adjMap[v] = append(ug.adjMap[v], w)
+	if v != w {
+		ug.adjMap[w] = append(ug.adjMap[w], v)
+	}
+	ug.nEdges++
+}
+
+func (ug *UGraph) GetNeighbors(v int) []int {
+	neighbors, ok := ug.adjMap[v]
+	if !ok {
+		return nil
+	}
+
+	copyNeighbors := make([]int, len(neighbors))
+	copy(copyNeighbors, neighbors)
+	return copyNeighbors
+}
+
+// Returns the number of edges incident to v, a self-loop contributes 2
+func (ug *UGraph) Degree(v int) int {
+	degree := 0
+	for _, w := range ug.adjMap[v] {
+		if w == v {
+			degree += 2
+		} else {
+			degree++
+		}
+	}
+
+	return degree
+}
+
+// Returns false since the edges of UGraph have no direction
+func (ug *UGraph) IsDirected() bool {
+	return false
+}
+
+// Returns true if the graph has the edge v-w
+func (ug *UGraph) HasEdge(v, w int) bool {
+	return indexOf(ug.adjMap[v], w) >= 0
+}
+
+// Removes one edge v-w, returns false if there is no such edge
+func (ug *UGraph) RemoveEdge(v, w int) bool {
+	neighbors, ok := removeNeighbor(ug.adjMap[v], w)
+	if !ok {
+		return false
+	}
+
+	ug.adjMap[v] = neighbors
+	if v != w {
+		ug.adjMap[w], _ = removeNeighbor(ug.adjMap[w], v)
+	}
+	ug.nEdges--
+	return true
+}
+
+// Removes v and all the edges incident to it, the vertices after v move down
+// by one. Returns the new id of every old vertex, -1 for v, or nil if the
+// graph has no vertex v.
+func (ug *UGraph) RemoveVertex(v int) []int {
+	if !ug.HasVertex(v) {
+		return nil
+	}
+
+	ids := removedVertexIDs(ug.nVertices, v)
+	adjMap := map[int][]int{}
+	nEdges := 0
+	for u := 0; u < ug.nVertices; u++ {
+		if u == v {
+			continue
+		}
+		for _, w := range ug.adjMap[u] {
+			if w == v {
+				continue
+			}
+			adjMap[ids[u]] = append(adjMap[ids[u]], ids[w])
+			if u <= w {
+				nEdges++
+			}
+		}
+	}
+
+	ug.adjMap = adjMap
+	ug.nVertices--
+	ug.nEdges = nEdges
+	return ids
+}
+
+// Returns a copy of the graph since reversing an undirected edge does not change it
+func (ug *UGraph) Reverse() *UGraph {
+	rg := &UGraph{
+		adjMap:    map[int][]int{},
+		nVertices: ug.nVertices,
+		nEdges:    ug.nEdges,
+	}
+	for v, neighbors := range ug.adjMap {
+		rg.adjMap[v] = append([]int(nil), neighbors...)
+	}
+
+	return rg
+}
+
+// Returns true unless g reports that it is undirected via IsDirected()
+func isDirected(g Graph) bool {
+	if d, ok := g.(interface{ IsDirected() bool }); ok {
+		return d.IsDirected()
+	}
+
+	return true
+}
+
+// Returns the neighbors of v without copying them if g supports it, the
+// result must not be modified.
+func neighbors(g Graph, v int) []int {
+	if ng, ok := g.(interface{ Neighbors(v int) []int }); ok {
+		return ng.Neighbors(v)
+	}
+
+	return g.GetNeighbors(v)
+}
+
+// Returns the new ids of the vertices of a graph with n vertices after
+// removing v, -1 for v itself.
+func removedVertexIDs(n, v int) []int {
+	ids := make([]int, n)
+	for u := range ids {
+		switch {
+		case u < v:
+			ids[u] = u
+		case u == v:
+			ids[u] = -1
+		default:
+			ids[u] = u - 1
+		}
+	}
+
+	return ids
+}
+
+// Returns the index of the first w in neighbors, -1 if w is not there
+func indexOf(neighbors []int, w int) int {
+	for i, u := range neighbors {
+		if u == w {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Removes the first w from neighbors keeping the order of the rest
+func removeNeighbor(neighbors []int, w int) ([]int, bool) {
+	i := indexOf(neighbors, w)
+	if i < 0 {
+		return neighbors, false
+	}
+
+	return append(neighbors[:i], neighbors[i+1:]...), true
+}
@@ -0,0 +1,155 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUGraph_Edges(t *testing.T) {
+	g := NewUGraph(4)
+	assert.Equal(t, 4, g.GetNumVertices())
+	assert.Equal(t, false, g.IsDirected())
+
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	assert.Equal(t, 2, g.GetNumEdges())
+	assert.Equal(t, []int{1}, g.GetNeighbors(0))
+	assert.Equal(t, []int{0, 2}, g.GetNeighbors(1))
+	assert.Equal(t, []int{1}, g.GetNeighbors(2))
+	assert.Nil(t, g.GetNeighbors(3))
+
+	// Self-loop
+	g.AddEdge(3, 3)
+	assert.Equal(t, 3, g.GetNumEdges())
+	assert.Equal(t, []int{3}, g.GetNeighbors(3))
+	assert.Equal(t, 2, g.Degree(3))
+
+	// Parallel edge
+	g.AddEdge(1, 0)
+	assert.Equal(t, 4, g.GetNumEdges())
+	assert.Equal(t, []int{1, 1}, g.GetNeighbors(0))
+	assert.Equal(t, 2, g.Degree(0))
+	assert.Equal(t, 3, g.Degree(1))
+}
+
+func TestUGraph_AddVertex(t *testing.T) {
+	g := NewUGraph(2)
+	assert.Equal(t, true, g.HasVertex(0))
+	assert.Equal(t, false, g.HasVertex(2))
+
+	v := g.AddVertex()
+	assert.Equal(t, 2, v)
+	assert.Equal(t, 3, g.GetNumVertices())
+	assert.Equal(t, true, g.HasVertex(v))
+	assert.Equal(t, 0, g.Degree(v))
+}
+
+func TestUGraph_Search(t *testing.T) {
+	g := NewUGraph(6)
+	g.AddEdge(0, 5)
+	g.AddEdge(2, 4)
+	g.AddEdge(2, 3)
+	g.AddEdge(1, 2)
+	g.AddEdge(0, 1)
+	g.AddEdge(3, 4)
+	g.AddEdge(3, 5)
+	g.AddEdge(0, 2)
+
+	dfs := NewSearch(DepthFirstSearch)
+	dfs.DoSearch(g, 3, -1)
+	assert.Equal(t, 6, dfs.Count())
+	path := dfs.PathTo(0)
+	assert.Equal(t, 3, path[0])
+	assert.Equal(t, 0, path[len(path)-1])
+
+	bfs := NewSearch(BreathFirstSearch)
+	bfs.DoSearch(g, 3, 0)
+	assert.Equal(t, []int{3, 2, 0}, bfs.PathTo(0))
+}
+
+func TestDGraph_AddVertex(t *testing.T) {
+	g := NewDGraph(0)
+	assert.Equal(t, false, g.HasVertex(0))
+
+	assert.Equal(t, 0, g.AddVertex())
+	assert.Equal(t, 1, g.AddVertex())
+	assert.Equal(t, true, g.HasVertex(0))
+	assert.Equal(t, true, g.HasVertex(1))
+	assert.Equal(t, false, g.HasVertex(2))
+}
+
+func TestDGraph_Remove(t *testing.T) {
+	g := NewDGraph(4).(*DGraph)
+	g.AddEdge(0, 1)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 0)
+	g.AddEdge(3, 1)
+	g.AddEdge(2, 3)
+
+	assert.Equal(t, true, g.HasEdge(0, 1))
+	assert.Equal(t, false, g.HasEdge(1, 0))
+
+	rg := g.Reverse()
+	assert.Equal(t, 6, rg.GetNumEdges())
+	assert.Equal(t, []int{0, 0, 3}, rg.GetNeighbors(1))
+	assert.Equal(t, []int{2}, rg.GetNeighbors(0))
+
+	// Parallel edges are removed one at a time
+	assert.Equal(t, true, g.RemoveEdge(0, 1))
+	assert.Equal(t, true, g.HasEdge(0, 1))
+	assert.Equal(t, true, g.RemoveEdge(0, 1))
+	assert.Equal(t, false, g.HasEdge(0, 1))
+	assert.Equal(t, false, g.RemoveEdge(0, 1))
+	assert.Equal(t, 4, g.GetNumEdges())
+
+	assert.Equal(t, []int{0, -1, 1, 2}, g.RemoveVertex(1))
+	assert.Equal(t, 3, g.GetNumVertices())
+	assert.Equal(t, 2, g.GetNumEdges())
+	assert.Nil(t, g.GetNeighbors(0))
+	assert.Equal(t, []int{0, 2}, g.GetNeighbors(1))
+	assert.Nil(t, g.GetNeighbors(2))
+	assert.Nil(t, g.RemoveVertex(3))
+
+	// The reverse graph is not changed by the removals
+	assert.Equal(t, 4, rg.GetNumVertices())
+	assert.Equal(t, []int{0, 0, 3}, rg.GetNeighbors(1))
+}
+
+func TestUGraph_Remove(t *testing.T) {
+	g := NewUGraph(4)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 2)
+	g.AddEdge(2, 3)
+	g.AddEdge(1, 0)
+
+	assert.Equal(t, true, g.HasEdge(1, 0))
+	assert.Equal(t, true, g.HasEdge(2, 2))
+	assert.Equal(t, false, g.HasEdge(0, 3))
+
+	rg := g.Reverse()
+	assert.Equal(t, 5, rg.GetNumEdges())
+	assert.Equal(t, g.GetNeighbors(1), rg.GetNeighbors(1))
+
+	assert.Equal(t, true, g.RemoveEdge(1, 0))
+	assert.Equal(t, []int{1}, g.GetNeighbors(0))
+	assert.Equal(t, []int{2, 0}, g.GetNeighbors(1))
+	assert.Equal(t, true, g.RemoveEdge(2, 2))
+	assert.Equal(t, []int{1, 3}, g.GetNeighbors(2))
+	assert.Equal(t, false, g.RemoveEdge(2, 2))
+	assert.Equal(t, 3, g.GetNumEdges())
+
+	g.AddEdge(3, 3)
+	assert.Equal(t, []int{0, 1, 2, -1}, g.RemoveVertex(3))
+	assert.Equal(t, 3, g.GetNumVertices())
+	assert.Equal(t, 2, g.GetNumEdges())
+	assert.Equal(t, []int{1}, g.GetNeighbors(2))
+
+	assert.Equal(t, []int{-1, 0, 1}, g.RemoveVertex(0))
+	assert.Equal(t, 1, g.GetNumEdges())
+	assert.Equal(t, []int{1}, g.GetNeighbors(0))
+	assert.Equal(t, []int{0}, g.GetNeighbors(1))
+	assert.Equal(t, 5, rg.GetNumEdges())
+}
@@ -0,0 +1,198 @@
+/*
+
+graphml.go
+
+MIT License
+
+Copyright (c) 2018 rezamirz
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package graph
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const graphMLNamespace = "http://graphml.graphdrawing.org/xmlns"
+
+type graphML struct {
+	XMLName xml.Name      `xml:"graphml"`
+	Xmlns   string        `xml:"xmlns,attr,omitempty"`
+	Keys    []graphMLKey  `xml:"key"`
+	Graph   *graphMLGraph `xml:"graph"`
+}
+
+type graphMLKey struct {
+	ID       string `xml:"id,attr"`
+	For      string `xml:"for,attr"`
+	AttrName string `xml:"attr.name,attr"`
+	AttrType string `xml:"attr.type,attr"`
+	Default  string `xml:"default,omitempty"`
+}
+
+type graphMLGraph struct {
+	ID          string        `xml:"id,attr"`
+	EdgeDefault string        `xml:"edgedefault,attr"`
+	Nodes       []graphMLNode `xml:"node"`
+	Edges       []graphMLEdge `xml:"edge"`
+}
+
+type graphMLNode struct {
+	ID string `xml:"id,attr"`
+}
+
+type graphMLEdge struct {
+	Source string        `xml:"source,attr"`
+	Target string        `xml:"target,attr"`
+	Data   []graphMLData `xml:"data"`
+}
+
+type graphMLData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+// WriteGraphML stores g as GraphML, vertex v becomes the node "n<v>". The
+// edges of the weighted graph types get a "weight" data element.
+func WriteGraphML(w io.Writer, g Graph) error {
+	doc := graphML{
+		Xmlns: graphMLNamespace,
+		Graph: &graphMLGraph{ID: "G", EdgeDefault: "undirected"},
+	}
+	if isDirected(g) {
+		doc.Graph.EdgeDefault = "directed"
+	}
+
+	switch g.(type) {
+	case *EdgeWeightedDigraph, *EdgeWeightedGraph:
+		doc.Keys = append(doc.Keys, graphMLKey{ID: "weight", For: "edge", AttrName: "weight", AttrType: "double"})
+	}
+
+	for v := 0; v < g.GetNumVertices(); v++ {
+		doc.Graph.Nodes = append(doc.Graph.Nodes, graphMLNode{ID: fmt.Sprintf("n%d", v)})
+	}
+
+	forEachEdge(g, func(v, w int, weight float64, weighted bool) {
+		e := graphMLEdge{Source: fmt.Sprintf("n%d", v), Target: fmt.Sprintf("n%d", w)}
+		if weighted {
+			e.Data = []graphMLData{{Key: "weight", Value: formatWeight(weight)}}
+		}
+		doc.Graph.Edges = append(doc.Graph.Edges, e)
+	})
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// ReadGraphML loads the first graph of a GraphML document. The nodes are
+// numbered in the order they appear and the graph is directed unless its
+// edgedefault is "undirected". If an edge key is named "weight" the graph is an
+// EdgeWeightedDigraph or an EdgeWeightedGraph and an edge without the weight
+// gets the default of the key or 1, otherwise it is a DGraph or a UGraph.
+// It also returns the GraphML id of every vertex.
+func ReadGraphML(r io.Reader) (Graph, []string, error) {
+	var doc graphML
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, nil, err
+	}
+
+	if doc.Graph == nil {
+		return nil, nil, fmt.Errorf("missing graph element")
+	}
+
+	weightKey := ""
+	defaultWeight := 1.0
+	for _, key := range doc.Keys {
+		if key.AttrName != "weight" || (key.For != "edge" && key.For != "all") {
+			continue
+		}
+
+		weightKey = key.ID
+		if s := strings.TrimSpace(key.Default); s != "" {
+			weight, err := strconv.ParseFloat(s, 64)
+			if err != nil {
+				return nil, nil, fmt.Errorf("key %q: %v", key.ID, err)
+			}
+			defaultWeight = weight
+		}
+	}
+
+	vertices := map[string]int{}
+	ids := make([]string, 0, len(doc.Graph.Nodes))
+	for _, node := range doc.Graph.Nodes {
+		if _, ok := vertices[node.ID]; ok {
+			return nil, nil, fmt.Errorf("duplicate node %q", node.ID)
+		}
+		vertices[node.ID] = len(ids)
+		ids = append(ids, node.ID)
+	}
+
+	directed := doc.Graph.EdgeDefault != "undirected"
+	g := newGraph(len(ids), directed, weightKey != "")
+	for i, e := range doc.Graph.Edges {
+		v, ok := vertices[e.Source]
+		if !ok {
+			return nil, nil, fmt.Errorf("edge %d: unknown source node %q", i, e.Source)
+		}
+
+		w, ok := vertices[e.Target]
+		if !ok {
+			return nil, nil, fmt.Errorf("edge %d: unknown target node %q", i, e.Target)
+		}
+
+		weight := defaultWeight
+		for _, data := range e.Data {
+			if weightKey != "" && data.Key == weightKey {
+				var err error
+				weight, err = strconv.ParseFloat(strings.TrimSpace(data.Value), 64)
+				if err != nil {
+					return nil, nil, fmt.Errorf("edge %d: %v", i, err)
+				}
+			}
+		}
+
+		switch wg := g.(type) {
+		case *EdgeWeightedDigraph:
+			wg.AddWeightedEdge(NewDirectedEdge(v, w, weight))
+		case *EdgeWeightedGraph:
+			wg.AddWeightedEdge(NewEdge(v, w, weight))
+		default:
+			g.AddEdge(v, w)
+		}
+	}
+
+	return g, ids, nil
+}
@@ -0,0 +1,129 @@
+package graph
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGraphML_RoundTrip(t *testing.T) {
+	tests := []struct {
+		filename string
+		directed bool
+	}{
+		{"data/tinyG.txt", false},
+		{"data/tinyDG.txt", true},
+		{"data/tinyEWG.txt", false},
+		{"data/tinyEWD.txt", true},
+	}
+
+	for _, test := range tests {
+		g, err := readFile(test.filename, test.directed)
+		assert.NoError(t, err)
+
+		var buf bytes.Buffer
+		assert.NoError(t, WriteGraphML(&buf, g))
+		g2, ids, err := ReadGraphML(&buf)
+		assert.NoError(t, err, test.filename)
+		assert.Equal(t, g.GetNumVertices(), len(ids), test.filename)
+		assert.Equal(t, "n1", ids[1], test.filename)
+		assert.IsType(t, g, g2, test.filename)
+		assert.Equal(t, g.GetNumVertices(), g2.GetNumVertices(), test.filename)
+		assert.Equal(t, g.GetNumEdges(), g2.GetNumEdges(), test.filename)
+
+		var want, got bytes.Buffer
+		assert.NoError(t, Write(&want, g))
+		assert.NoError(t, Write(&got, g2))
+		assert.Equal(t, want.String(), got.String(), test.filename)
+	}
+}
+
+func TestReadGraphML_IDs(t *testing.T) {
+	doc := `<graphml>
+  <graph edgedefault="directed">
+    <node id="JFK"/>
+    <node id="ORD"/>
+    <node id="LAX"/>
+    <edge source="LAX" target="JFK"/>
+    <edge source="JFK" target="ORD"/>
+  </graph>
+</graphml>`
+
+	g, ids, err := ReadGraphML(strings.NewReader(doc))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"JFK", "ORD", "LAX"}, ids)
+	assert.Equal(t, []int{0}, g.GetNeighbors(2))
+	assert.Equal(t, []int{1}, g.GetNeighbors(0))
+
+	// The ids label the vertices of a SymbolGraph built from the edges
+	sg := NewSymbolGraph(true)
+	for v := 0; v < g.GetNumVertices(); v++ {
+		for _, w := range g.GetNeighbors(v) {
+			assert.NoError(t, sg.AddEdge(ids[v], ids[w]))
+		}
+	}
+	bfs := NewSearch(BreathFirstSearch)
+	assert.NoError(t, sg.Search(bfs, "LAX", "ORD"))
+	assert.Equal(t, []string{"LAX", "JFK", "ORD"}, sg.PathTo(bfs, "ORD"))
+}
+
+func TestWriteGraphML(t *testing.T) {
+	g := NewEdgeWeightedDigraph(2)
+	g.AddWeightedEdge(NewDirectedEdge(0, 1, 0.5))
+
+	var buf bytes.Buffer
+	assert.NoError(t, WriteGraphML(&buf, g))
+	out := buf.String()
+	assert.Contains(t, out, `<graphml xmlns="http://graphml.graphdrawing.org/xmlns">`)
+	assert.Contains(t, out, `<key id="weight" for="edge" attr.name="weight" attr.type="double"></key>`)
+	assert.Contains(t, out, `<graph id="G" edgedefault="directed">`)
+	assert.Contains(t, out, `<edge source="n0" target="n1">`)
+	assert.Contains(t, out, `<data key="weight">0.5</data>`)
+}
+
+func TestReadGraphML(t *testing.T) {
+	doc := `<?xml version="1.0" encoding="UTF-8"?>
+<graphml xmlns="http://graphml.graphdrawing.org/xmlns">
+  <key id="d0" for="node" attr.name="color" attr.type="string"/>
+  <key id="d1" for="edge" attr.name="weight" attr.type="double">
+    <default>2.5</default>
+  </key>
+  <graph id="G" edgedefault="undirected">
+    <node id="a"><data key="d0">green</data></node>
+    <node id="b"/>
+    <node id="c"/>
+    <edge source="a" target="b"><data key="d1">1.0</data></edge>
+    <edge source="c" target="b"/>
+  </graph>
+</graphml>`
+
+	g, ids, err := ReadGraphML(strings.NewReader(doc))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, ids)
+	wg, ok := g.(*EdgeWeightedGraph)
+	assert.True(t, ok)
+	assert.Equal(t, 3, wg.GetNumVertices())
+	assert.Equal(t, []Edge{NewEdge(0, 1, 1), NewEdge(2, 1, 2.5)}, wg.Edges())
+
+	// Without a weight key the graph is unweighted and directed by default
+	doc = `<graphml><graph id="G"><node id="x"/><node id="y"/><edge source="y" target="x"/></graph></graphml>`
+	g, ids, err = ReadGraphML(strings.NewReader(doc))
+	assert.NoError(t, err)
+	assert.IsType(t, &DGraph{}, g)
+	assert.Equal(t, []string{"x", "y"}, ids)
+	assert.Equal(t, []int{0}, g.GetNeighbors(1))
+
+	for _, bad := range []string{
+		`<graphml></graphml>`,
+		`<graphml><graph><node id="x"/><node id="x"/></graph></graphml>`,
+		`<graphml><graph><node id="x"/><edge source="x" target="y"/></graph></graphml>`,
+		`<graphml><key id="w" for="edge" attr.name="weight"/><graph><node id="x"/>` +
+			`<edge source="x" target="x"><data key="w">heavy</data></edge></graph></graphml>`,
+		`<graphml><graph>`,
+	} {
+		_, _, err := ReadGraphML(strings.NewReader(bad))
+		assert.Error(t, err, bad)
+	}
+}
@@ -0,0 +1,212 @@
+/*
+
+io.go
+
+MIT License
+
+Copyright (c) 2018 rezamirz
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package graph
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// The error returned by Read() with the line number where parsing failed
+type ParseError struct {
+	Line int
+	Err  error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+/*
+ * Read loads a graph in the format of data/tinyG.txt:
+ *
+ *    V
+ *    E
+ *    v w [weight]
+ *    ...
+ *
+ * V and E may also share the first line. Fields can be separated by any
+ * whitespace, blank lines are ignored and everything after '#' is a comment.
+ * When the edges have a weight column the graph is an EdgeWeightedDigraph or
+ * an EdgeWeightedGraph, otherwise it is a DGraph or a UGraph, depending on
+ * directed. The number of edges must match E.
+ */
+func Read(r io.Reader, directed bool) (Graph, error) {
+	var g Graph
+	header := []int{}
+	nEdges := 0
+	nColumns := 0
+	lineNo := 0
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		lineNo++
+		s := scanner.Text()
+		if i := strings.IndexByte(s, '#'); i >= 0 {
+			s = s[:i]
+		}
+
+		fields := strings.Fields(s)
+		if len(fields) == 0 {
+			continue
+		}
+
+		// Reading V and E
+		if len(header) < 2 {
+			if len(header)+len(fields) > 2 {
+				return nil, &ParseError{lineNo, fmt.Errorf("unexpected fields after header %q", s)}
+			}
+
+			for _, field := range fields {
+				n, err := strconv.Atoi(field)
+				if err != nil {
+					return nil, &ParseError{lineNo, err}
+				}
+				if n < 0 {
+					return nil, &ParseError{lineNo, fmt.Errorf("negative count %d in header", n)}
+				}
+				header = append(header, n)
+			}
+			continue
+		}
+
+		if nColumns == 0 {
+			if len(fields) != 2 && len(fields) != 3 {
+				return nil, &ParseError{lineNo, fmt.Errorf("expected 2 or 3 fields, got %d", len(fields))}
+			}
+			nColumns = len(fields)
+			g = newGraph(header[0], directed, nColumns == 3)
+		} else if len(fields) != nColumns {
+			return nil, &ParseError{lineNo, fmt.Errorf("expected %d fields, got %d", nColumns, len(fields))}
+		}
+
+		if nEdges == header[1] {
+			return nil, &ParseError{lineNo, fmt.Errorf("more than %d edges", header[1])}
+		}
+
+		v, err := parseVertex(fields[0], header[0])
+		if err != nil {
+			return nil, &ParseError{lineNo, err}
+		}
+
+		w, err := parseVertex(fields[1], header[0])
+		if err != nil {
+			return nil, &ParseError{lineNo, err}
+		}
+
+		if nColumns == 3 {
+			weight, err := strconv.ParseFloat(fields[2], 64)
+			if err != nil {
+				return nil, &ParseError{lineNo, err}
+			}
+
+			switch wg := g.(type) {
+			case *EdgeWeightedDigraph:
+				wg.AddWeightedEdge(NewDirectedEdge(v, w, weight))
+			case *EdgeWeightedGraph:
+				wg.AddWeightedEdge(NewEdge(v, w, weight))
+			}
+		} else {
+			g.AddEdge(v, w)
+		}
+		nEdges++
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, &ParseError{lineNo, err}
+	}
+
+	if len(header) < 2 {
+		return nil, &ParseError{lineNo, fmt.Errorf("missing V and E header")}
+	}
+
+	if nEdges != header[1] {
+		return nil, &ParseError{lineNo, fmt.Errorf("expected %d edges, got %d", header[1], nEdges)}
+	}
+
+	if g == nil {
+		g = newGraph(header[0], directed, false)
+	}
+
+	return g, nil
+}
+
+func newGraph(nVertices int, directed, weighted bool) Graph {
+	switch {
+	case directed && weighted:
+		return NewEdgeWeightedDigraph(nVertices)
+	case weighted:
+		return NewEdgeWeightedGraph(nVertices)
+	case directed:
+		return NewDGraph(nVertices)
+	}
+
+	return NewUGraph(nVertices)
+}
+
+func parseVertex(s string, nVertices int) (int, error) {
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, err
+	}
+
+	if v < 0 || v >= nVertices {
+		return 0, fmt.Errorf("vertex %d out of range [0, %d)", v, nVertices)
+	}
+
+	return v, nil
+}
+
+// Write stores g in the format accepted by Read(). Edges of the weighted graph
+// types are written with their weight, edges of undirected graphs are written once.
+func Write(w io.Writer, g Graph) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "%d\n%d\n", g.GetNumVertices(), g.GetNumEdges())
+
+	forEachEdge(g, func(from, to int, weight float64, weighted bool) {
+		if weighted {
+			fmt.Fprintf(bw, "%d %d %s\n", from, to, formatWeight(weight))
+		} else {
+			fmt.Fprintf(bw, "%d %d\n", from, to)
+		}
+	})
+
+	return bw.Flush()
+}
+
+func formatWeight(weight float64) string {
+	return strconv.FormatFloat(weight, 'g', -1, 64)
+}
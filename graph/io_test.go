@@ -0,0 +1,112 @@
+package graph
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func readFile(filename string, directed bool) (Graph, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+	return Read(f, directed)
+}
+
+func TestRead_TinyG(t *testing.T) {
+	g, err := readFile("data/tinyG.txt", true)
+	assert.NoError(t, err)
+	assert.IsType(t, &DGraph{}, g)
+	assert.Equal(t, 6, g.GetNumVertices())
+	assert.Equal(t, 8, g.GetNumEdges())
+	assert.Equal(t, []int{5, 1, 2}, g.GetNeighbors(0))
+
+	g, err = readFile("data/tinyG.txt", false)
+	assert.NoError(t, err)
+	assert.IsType(t, &UGraph{}, g)
+	assert.Equal(t, 8, g.GetNumEdges())
+	assert.Equal(t, []int{4, 3, 1}, g.GetNeighbors(2)[:3])
+}
+
+func TestRead_Weighted(t *testing.T) {
+	g, err := readFile("data/tinyEWD.txt", true)
+	assert.NoError(t, err)
+	ewd, ok := g.(*EdgeWeightedDigraph)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, 8, ewd.GetNumVertices())
+	assert.Equal(t, 15, ewd.GetNumEdges())
+	assert.Equal(t, []DirectedEdge{NewDirectedEdge(0, 4, 0.38), NewDirectedEdge(0, 2, 0.26)}, ewd.Adj(0))
+
+	g, err = readFile("data/tinyEWD.txt", false)
+	assert.NoError(t, err)
+	assert.IsType(t, &EdgeWeightedGraph{}, g)
+	assert.Equal(t, 15, g.GetNumEdges())
+}
+
+func TestRead_Format(t *testing.T) {
+	input := "# A comment\n\n3 2   # V and E\n  0\t1 \n\n1   2 # last edge\n"
+	g, err := Read(strings.NewReader(input), false)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, g.GetNumVertices())
+	assert.Equal(t, 2, g.GetNumEdges())
+	assert.Equal(t, []int{0, 2}, g.GetNeighbors(1))
+
+	g, err = Read(strings.NewReader("4\n0\n"), true)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, g.GetNumVertices())
+	assert.Equal(t, 0, g.GetNumEdges())
+}
+
+func TestRead_Errors(t *testing.T) {
+	tests := []struct {
+		input string
+		line  int
+	}{
+		{"", 0},
+		{"3\n", 1},
+		{"x\n1\n", 1},
+		{"3\n-1\n", 2},
+		{"3 1 2\n", 1},
+		{"3\n1\n0 a\n", 3},
+		{"3\n1\n0 3\n", 3},
+		{"3\n1\n0\n", 3},
+		{"3\n2\n0 1\n1 2 0.5\n", 4},
+		{"3\n1\n0 1 x\n", 3},
+		{"3\n1\n0 1\n1 2\n", 4},
+		{"3\n3\n0 1\n1 2\n", 4},
+	}
+
+	for _, test := range tests {
+		_, err := Read(strings.NewReader(test.input), true)
+		var parseErr *ParseError
+		assert.Equal(t, true, errors.As(err, &parseErr), test.input)
+		assert.Equal(t, test.line, parseErr.Line, test.input)
+	}
+}
+
+func TestWrite_RoundTrip(t *testing.T) {
+	for _, filename := range []string{"data/tinyG.txt", "data/tinyEWD.txt"} {
+		for _, directed := range []bool{true, false} {
+			g, err := readFile(filename, directed)
+			assert.NoError(t, err)
+
+			var buf bytes.Buffer
+			assert.NoError(t, Write(&buf, g))
+
+			g2, err := Read(&buf, directed)
+			assert.NoError(t, err)
+			assert.Equal(t, g.GetNumVertices(), g2.GetNumVertices())
+			assert.Equal(t, g.GetNumEdges(), g2.GetNumEdges())
+			for v := 0; v < g.GetNumVertices(); v++ {
+				assert.ElementsMatch(t, g.GetNeighbors(v), g2.GetNeighbors(v))
+			}
+		}
+	}
+}
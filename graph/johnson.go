@@ -0,0 +1,159 @@
+/*
+
+johnson.go
+
+MIT License
+
+Copyright (c) 2018 rezamirz
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package graph
+
+import (
+	"fmt"
+	"math"
+	"runtime"
+	"sync"
+
+	algo_error "github.com/rezamirz/myalgos/error"
+)
+
+// All pairs shortest paths with Johnson's algorithm in O(V E log V) time,
+// suitable for large sparse graphs. The weights can be negative: Bellman-Ford
+// finds the potentials h that make the weights w(u, v) + h(u) - h(v)
+// non-negative, then Dijkstra runs from every vertex on the reweighted graph.
+type Johnson struct {
+	g  *EdgeWeightedDigraph
+	h  []float64     // Potential of v
+	sp []*DijkstraSP // Shortest paths from u in the reweighted graph
+}
+
+// If parallel is true the Dijkstra searches run in GOMAXPROCS goroutines.
+// Returns an error wrapping NEGATIVE_CYCLE if g has a negative cycle.
+func NewJohnson(g *EdgeWeightedDigraph, parallel bool) (*Johnson, error) {
+	n := g.GetNumVertices()
+	j := &Johnson{
+		g:  g,
+		h:  make([]float64, n),
+		sp: make([]*DijkstraSP, n),
+	}
+
+	// A new vertex n with an edge of weight 0 to every vertex
+	ag := NewEdgeWeightedDigraph(n + 1)
+	for _, e := range g.Edges() {
+		ag.AddWeightedEdge(e)
+	}
+	for v := 0; v < n; v++ {
+		ag.AddWeightedEdge(NewDirectedEdge(n, v, 0))
+	}
+
+	bf, err := NewBellmanFordSP(ag, n)
+	if err != nil {
+		return nil, err
+	}
+
+	if bf.HasNegativeCycle() {
+		return nil, fmt.Errorf("cycle %v: %w", bf.NegativeCycle(), algo_error.NEGATIVE_CYCLE)
+	}
+
+	for v := 0; v < n; v++ {
+		j.h[v] = bf.DistTo(v)
+	}
+
+	rg := NewEdgeWeightedDigraph(n)
+	for _, e := range g.Edges() {
+		// Clamp the floating point errors that make a weight negative
+		weight := math.Max(0, e.Weight()+j.h[e.From()]-j.h[e.To()])
+		rg.AddWeightedEdge(NewDirectedEdge(e.From(), e.To(), weight))
+	}
+
+	workers := 1
+	if parallel {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	sources := make(chan int, n)
+	for u := 0; u < n; u++ {
+		sources <- u
+	}
+	close(sources)
+
+	errs := make([]error, workers)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for u := range sources {
+				sp, err := NewDijkstraSP(rg, u)
+				if err != nil {
+					errs[i] = err
+					return
+				}
+				j.sp[u] = sp
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return j, nil
+}
+
+// Returns the length of the shortest path from u to v, +Inf if there is no path
+func (j *Johnson) Dist(u, v int) float64 {
+	if !j.sp[u].HasPathTo(v) {
+		return math.Inf(1)
+	}
+
+	return j.sp[u].DistTo(v) - j.h[u] + j.h[v]
+}
+
+func (j *Johnson) HasPath(u, v int) bool {
+	return j.sp[u].HasPathTo(v)
+}
+
+// Returns the edges of g on the shortest path from u to v, nil if there is no path
+func (j *Johnson) Path(u, v int) []DirectedEdge {
+	path := j.sp[u].PathTo(v)
+	if path == nil {
+		return nil
+	}
+
+	// Reweighting keeps the order of the parallel edges, the lightest one is on the path
+	for i, re := range path {
+		found := false
+		for _, e := range j.g.Adj(re.From()) {
+			if e.To() == re.To() && (!found || e.Weight() < path[i].Weight()) {
+				path[i] = e
+				found = true
+			}
+		}
+	}
+
+	return path
+}
@@ -0,0 +1,71 @@
+package graph
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	algo_error "github.com/rezamirz/myalgos/error"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJohnson(t *testing.T) {
+	g, err := readFile("data/tinyEWDn.txt", true)
+	assert.NoError(t, err)
+	ewd := g.(*EdgeWeightedDigraph)
+
+	fw := NewFloydWarshall(ewd)
+	for _, parallel := range []bool{false, true} {
+		j, err := NewJohnson(ewd, parallel)
+		assert.NoError(t, err)
+
+		for u := 0; u < ewd.GetNumVertices(); u++ {
+			for v := 0; v < ewd.GetNumVertices(); v++ {
+				assert.Equal(t, fw.HasPath(u, v), j.HasPath(u, v))
+				assert.InDelta(t, fw.Dist(u, v), j.Dist(u, v), 1e-9)
+				assert.Equal(t, fw.Path(u, v), j.Path(u, v))
+			}
+		}
+	}
+}
+
+func TestJohnson_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 20; i++ {
+		n := 1 + r.Intn(30)
+		g := NewEdgeWeightedDigraph(n)
+		potential := make([]float64, n)
+		for v := range potential {
+			potential[v] = float64(r.Intn(20))
+		}
+
+		// Reweighting non-negative weights by a potential leaves no negative cycle
+		for e := r.Intn(4 * n); e > 0; e-- {
+			u, v := r.Intn(n), r.Intn(n)
+			weight := float64(r.Intn(10)) + potential[u] - potential[v]
+			g.AddWeightedEdge(NewDirectedEdge(u, v, weight))
+		}
+
+		fw := NewFloydWarshall(g)
+		assert.Equal(t, false, fw.HasNegativeCycle())
+		j, err := NewJohnson(g, true)
+		assert.NoError(t, err)
+		for u := 0; u < n; u++ {
+			for v := 0; v < n; v++ {
+				assert.Equal(t, fw.HasPath(u, v), j.HasPath(u, v))
+				if j.HasPath(u, v) {
+					assert.InDelta(t, fw.Dist(u, v), j.Dist(u, v), 1e-9)
+					assert.InDelta(t, j.Dist(u, v), pathWeight(j.Path(u, v)), 1e-9)
+				}
+			}
+		}
+	}
+}
+
+func TestJohnson_NegativeCycle(t *testing.T) {
+	g, err := readFile("data/tinyEWDnc.txt", true)
+	assert.NoError(t, err)
+
+	_, err = NewJohnson(g.(*EdgeWeightedDigraph), false)
+	assert.Equal(t, true, errors.Is(err, algo_error.NEGATIVE_CYCLE))
+}
@@ -0,0 +1,208 @@
+/*
+
+matching.go
+
+MIT License
+
+Copyright (c) 2018 rezamirz
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package graph
+
+import (
+	"fmt"
+	"math"
+
+	algo_error "github.com/rezamirz/myalgos/error"
+	"github.com/rezamirz/myalgos/util"
+)
+
+// Maximum cardinality matching in a bipartite graph with Hopcroft-Karp. Each
+// phase finds a maximal set of vertex disjoint shortest augmenting paths, so
+// it runs in O(E sqrt(V)).
+type HopcroftKarp struct {
+	b         *Bipartite
+	adj       [][]int // Neighbors of v
+	mate      []int   // Vertex matched to v, -1 if v is not matched
+	size      int     // Number of edges in the matching
+	dist      []int   // Layer of the left vertex v in the current phase
+	next      []int   // Next neighbor of v to try in the current phase
+	inCover   []bool  // Is v in the minimum vertex cover
+	nVertices int
+}
+
+// Returns an error wrapping NOT_BIPARTITE if g is not bipartite
+func NewHopcroftKarp(g Graph) (*HopcroftKarp, error) {
+	b := NewBipartite(g)
+	if !b.IsBipartite() {
+		return nil, fmt.Errorf("odd cycle %v: %w", b.OddCycle(), algo_error.NOT_BIPARTITE)
+	}
+
+	n := g.GetNumVertices()
+	hk := &HopcroftKarp{
+		b:         b,
+		adj:       make([][]int, n),
+		mate:      make([]int, n),
+		dist:      make([]int, n),
+		next:      make([]int, n),
+		inCover:   make([]bool, n),
+		nVertices: n,
+	}
+
+	for v := 0; v < n; v++ {
+		hk.adj[v] = neighbors(g, v)
+		hk.mate[v] = -1
+	}
+
+	for hk.bfs() {
+		for v := 0; v < n; v++ {
+			hk.next[v] = 0
+		}
+		for v := 0; v < n; v++ {
+			if hk.isLeft(v) && hk.mate[v] == -1 && hk.dfs(v) {
+				hk.size++
+			}
+		}
+	}
+
+	hk.minVertexCover()
+	return hk, nil
+}
+
+func (hk *HopcroftKarp) isLeft(v int) bool {
+	return !hk.b.Color(v)
+}
+
+// Builds the layers of the alternating paths from the free left vertices,
+// returns true if there is an augmenting path.
+func (hk *HopcroftKarp) bfs() bool {
+	q := util.NewQueue()
+	for v := 0; v < hk.nVertices; v++ {
+		hk.dist[v] = math.MaxInt32
+		if hk.isLeft(v) && hk.mate[v] == -1 {
+			hk.dist[v] = 0
+			q.Push(v)
+		}
+	}
+
+	found := false
+	for q.Len() > 0 {
+		v := q.Pop().(int)
+		for _, w := range hk.adj[v] {
+			u := hk.mate[w]
+			if u == -1 {
+				found = true
+			} else if hk.dist[u] == math.MaxInt32 {
+				hk.dist[u] = hk.dist[v] + 1
+				q.Push(u)
+			}
+		}
+	}
+
+	return found
+}
+
+// Looks for an augmenting path from the left vertex v along the layers and
+// flips the matching on it.
+func (hk *HopcroftKarp) dfs(v int) bool {
+	for ; hk.next[v] < len(hk.adj[v]); hk.next[v]++ {
+		w := hk.adj[v][hk.next[v]]
+		u := hk.mate[w]
+		if u == -1 || (hk.dist[u] == hk.dist[v]+1 && hk.dfs(u)) {
+			hk.mate[v] = w
+			hk.mate[w] = v
+			return true
+		}
+	}
+
+	hk.dist[v] = math.MaxInt32
+	return false
+}
+
+// König's theorem: with Z the vertices reachable from the free left vertices
+// by alternating paths, (L - Z) + (R & Z) is a minimum vertex cover.
+func (hk *HopcroftKarp) minVertexCover() {
+	marked := make([]bool, hk.nVertices)
+	q := util.NewQueue()
+	for v := 0; v < hk.nVertices; v++ {
+		if hk.isLeft(v) && hk.mate[v] == -1 {
+			marked[v] = true
+			q.Push(v)
+		}
+	}
+
+	for q.Len() > 0 {
+		v := q.Pop().(int)
+		for _, w := range hk.adj[v] {
+			// Left to right by an edge not in the matching, right to left by the matching
+			if marked[w] || (hk.isLeft(v) && hk.mate[v] == w) || (!hk.isLeft(v) && hk.mate[v] != w) {
+				continue
+			}
+			marked[w] = true
+			q.Push(w)
+		}
+	}
+
+	for v := 0; v < hk.nVertices; v++ {
+		hk.inCover[v] = hk.isLeft(v) != marked[v]
+	}
+}
+
+// Returns the vertex matched to v, -1 if v is not matched
+func (hk *HopcroftKarp) Mate(v int) int {
+	return hk.mate[v]
+}
+
+func (hk *HopcroftKarp) IsMatched(v int) bool {
+	return hk.mate[v] != -1
+}
+
+// Returns the number of edges in the matching
+func (hk *HopcroftKarp) Size() int {
+	return hk.size
+}
+
+// Returns true if every vertex is matched
+func (hk *HopcroftKarp) IsPerfect() bool {
+	return 2*hk.size == hk.nVertices
+}
+
+// Returns the side of v, the left side is where the matching is searched from
+func (hk *HopcroftKarp) IsLeft(v int) bool {
+	return hk.isLeft(v)
+}
+
+func (hk *HopcroftKarp) InMinVertexCover(v int) bool {
+	return hk.inCover[v]
+}
+
+// Returns the vertices of a minimum vertex cover, it has Size() vertices
+func (hk *HopcroftKarp) MinVertexCover() []int {
+	cover := make([]int, 0, hk.size)
+	for v := 0; v < hk.nVertices; v++ {
+		if hk.inCover[v] {
+			cover = append(cover, v)
+		}
+	}
+
+	return cover
+}
@@ -0,0 +1,124 @@
+package graph
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	algo_error "github.com/rezamirz/myalgos/error"
+	"github.com/stretchr/testify/assert"
+)
+
+func assertMatching(t *testing.T, g Graph, hk *HopcroftKarp) {
+	size := 0
+	for v := 0; v < g.GetNumVertices(); v++ {
+		w := hk.Mate(v)
+		if w == -1 {
+			assert.Equal(t, false, hk.IsMatched(v))
+			continue
+		}
+		assert.Equal(t, v, hk.Mate(w))
+		assert.Contains(t, g.GetNeighbors(v), w)
+		if hk.IsLeft(v) {
+			size++
+		}
+	}
+	assert.Equal(t, hk.Size(), size)
+
+	// The cover touches every edge and has as many vertices as the matching
+	assert.Equal(t, hk.Size(), len(hk.MinVertexCover()))
+	for v := 0; v < g.GetNumVertices(); v++ {
+		for _, w := range g.GetNeighbors(v) {
+			assert.Equal(t, true, hk.InMinVertexCover(v) || hk.InMinVertexCover(w))
+		}
+	}
+}
+
+func TestHopcroftKarp(t *testing.T) {
+	// Workers 0-3, jobs 4-7
+	g := NewUGraph(8)
+	g.AddEdge(0, 4)
+	g.AddEdge(0, 5)
+	g.AddEdge(1, 4)
+	g.AddEdge(2, 5)
+	g.AddEdge(2, 6)
+	g.AddEdge(3, 6)
+	g.AddEdge(3, 7)
+
+	hk, err := NewHopcroftKarp(g)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, hk.Size())
+	assert.Equal(t, true, hk.IsPerfect())
+	assert.Equal(t, 4, hk.Mate(1))
+	assertMatching(t, g, hk)
+
+	// Workers 0-2 all want job 3, only worker 0 can do job 4 and 5 is idle
+	g = NewUGraph(6)
+	g.AddEdge(0, 3)
+	g.AddEdge(0, 4)
+	g.AddEdge(1, 3)
+	g.AddEdge(2, 3)
+
+	hk, err = NewHopcroftKarp(g)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, hk.Size())
+	assert.Equal(t, false, hk.IsPerfect())
+	assertMatching(t, g, hk)
+}
+
+func TestHopcroftKarp_NotBipartite(t *testing.T) {
+	g, err := readFile("data/tinyG.txt", false)
+	assert.NoError(t, err)
+
+	_, err = NewHopcroftKarp(g)
+	assert.Equal(t, true, errors.Is(err, algo_error.NOT_BIPARTITE))
+}
+
+// Size of the maximum matching with simple augmenting paths
+func kuhnMatching(g Graph, left int) int {
+	mate := make([]int, g.GetNumVertices())
+	for v := range mate {
+		mate[v] = -1
+	}
+
+	var augment func(v int, visited []bool) bool
+	augment = func(v int, visited []bool) bool {
+		for _, w := range g.GetNeighbors(v) {
+			if visited[w] {
+				continue
+			}
+			visited[w] = true
+			if mate[w] == -1 || augment(mate[w], visited) {
+				mate[w] = v
+				return true
+			}
+		}
+		return false
+	}
+
+	size := 0
+	for v := 0; v < left; v++ {
+		if augment(v, make([]bool, g.GetNumVertices())) {
+			size++
+		}
+	}
+
+	return size
+}
+
+func TestHopcroftKarp_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		left := 1 + r.Intn(20)
+		right := 1 + r.Intn(20)
+		g := NewUGraph(left + right)
+		for e := r.Intn(3 * (left + right)); e > 0; e-- {
+			g.AddEdge(r.Intn(left), left+r.Intn(right))
+		}
+
+		hk, err := NewHopcroftKarp(g)
+		assert.NoError(t, err)
+		assert.Equal(t, kuhnMatching(g, left), hk.Size())
+		assertMatching(t, g, hk)
+	}
+}
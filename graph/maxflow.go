@@ -0,0 +1,217 @@
+/*
+
+maxflow.go
+
+MIT License
+
+Copyright (c) 2018 rezamirz
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package graph
+
+import (
+	"fmt"
+	"math"
+
+	algo_error "github.com/rezamirz/myalgos/error"
+	"github.com/rezamirz/myalgos/util"
+)
+
+// Maximum flow from s to t in a FlowNetwork. The solvers set the flow of
+// every edge of the network, the flow of an edge is read by FlowEdge.Flow().
+type MaxFlow struct {
+	s      int
+	t      int
+	value  float64 // Value of the flow
+	inCut  []bool  // Is v reachable from s in the residual network
+	edgeTo []*FlowEdge
+	level  []int
+	next   []int
+}
+
+func newMaxFlow(net *FlowNetwork, s, t int) (*MaxFlow, error) {
+	if err := checkFlowNetwork(net, s, t); err != nil {
+		return nil, err
+	}
+
+	net.resetFlow()
+	return &MaxFlow{
+		s:     s,
+		t:     t,
+		inCut: make([]bool, net.GetNumVertices()),
+	}, nil
+}
+
+func checkFlowNetwork(net *FlowNetwork, s, t int) error {
+	if !net.HasVertex(s) || !net.HasVertex(t) || s == t {
+		return algo_error.INVALID_ARGUMENT
+	}
+
+	for _, e := range net.Edges() {
+		if e.Capacity() < 0 {
+			return fmt.Errorf("edge %v has negative capacity: %w", e, algo_error.INVALID_ARGUMENT)
+		}
+	}
+
+	return nil
+}
+
+// Edmonds-Karp: augments the flow along the shortest paths in the residual network
+func NewEdmondsKarp(net *FlowNetwork, s, t int) (*MaxFlow, error) {
+	mf, err := newMaxFlow(net, s, t)
+	if err != nil {
+		return nil, err
+	}
+
+	mf.edgeTo = make([]*FlowEdge, net.GetNumVertices())
+	for mf.hasAugmentingPath(net) {
+		bottleneck := math.Inf(1)
+		for v := t; v != s; v = mf.edgeTo[v].Other(v) {
+			bottleneck = math.Min(bottleneck, mf.edgeTo[v].ResidualCapacityTo(v))
+		}
+
+		for v := t; v != s; v = mf.edgeTo[v].Other(v) {
+			mf.edgeTo[v].addResidualFlowTo(v, bottleneck)
+		}
+
+		mf.value += bottleneck
+	}
+
+	return mf, nil
+}
+
+// Breadth first search in the residual network, it leaves inCut marking the
+// vertices reachable from s.
+func (mf *MaxFlow) hasAugmentingPath(net *FlowNetwork) bool {
+	for v := range mf.inCut {
+		mf.inCut[v] = false
+	}
+
+	q := util.NewQueue()
+	mf.inCut[mf.s] = true
+	q.Push(mf.s)
+	for q.Len() > 0 && !mf.inCut[mf.t] {
+		v := q.Pop().(int)
+		for _, e := range net.adjMap[v] {
+			w := e.Other(v)
+			if mf.inCut[w] || e.ResidualCapacityTo(w) <= 0 {
+				continue
+			}
+			mf.edgeTo[w] = e
+			mf.inCut[w] = true
+			q.Push(w)
+		}
+	}
+
+	return mf.inCut[mf.t]
+}
+
+// Dinic: augments the flow with a blocking flow of the level graph of the
+// residual network in each phase.
+func NewDinic(net *FlowNetwork, s, t int) (*MaxFlow, error) {
+	mf, err := newMaxFlow(net, s, t)
+	if err != nil {
+		return nil, err
+	}
+
+	mf.level = make([]int, net.GetNumVertices())
+	mf.next = make([]int, net.GetNumVertices())
+	for mf.buildLevels(net) {
+		for v := range mf.next {
+			mf.next[v] = 0
+		}
+
+		for {
+			pushed := mf.push(net, s, math.Inf(1))
+			if pushed <= 0 {
+				break
+			}
+			mf.value += pushed
+		}
+	}
+
+	for v := range mf.inCut {
+		mf.inCut[v] = mf.level[v] >= 0
+	}
+
+	return mf, nil
+}
+
+// Labels every vertex with its distance from s in the residual network,
+// returns true if t is reachable.
+func (mf *MaxFlow) buildLevels(net *FlowNetwork) bool {
+	for v := range mf.level {
+		mf.level[v] = -1
+	}
+
+	q := util.NewQueue()
+	mf.level[mf.s] = 0
+	q.Push(mf.s)
+	for q.Len() > 0 {
+		v := q.Pop().(int)
+		for _, e := range net.adjMap[v] {
+			w := e.Other(v)
+			if mf.level[w] >= 0 || e.ResidualCapacityTo(w) <= 0 {
+				continue
+			}
+			mf.level[w] = mf.level[v] + 1
+			q.Push(w)
+		}
+	}
+
+	return mf.level[mf.t] >= 0
+}
+
+// Sends up to limit flow from v to t along the level graph, returns the flow sent
+func (mf *MaxFlow) push(net *FlowNetwork, v int, limit float64) float64 {
+	if v == mf.t {
+		return limit
+	}
+
+	edges := net.adjMap[v]
+	for ; mf.next[v] < len(edges); mf.next[v]++ {
+		e := edges[mf.next[v]]
+		w := e.Other(v)
+		residual := e.ResidualCapacityTo(w)
+		if mf.level[w] != mf.level[v]+1 || residual <= 0 {
+			continue
+		}
+
+		pushed := mf.push(net, w, math.Min(limit, residual))
+		if pushed > 0 {
+			e.addResidualFlowTo(w, pushed)
+			return pushed
+		}
+	}
+
+	return 0
+}
+
+// Returns the value of the maximum flow
+func (mf *MaxFlow) Value() float64 {
+	return mf.value
+}
+
+// Returns true if v is on the s side of the minimum cut
+func (mf *MaxFlow) InCut(v int) bool {
+	return mf.inCut[v]
+}
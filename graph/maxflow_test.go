@@ -0,0 +1,144 @@
+package graph
+
+import (
+	"math/rand"
+	"testing"
+
+	algo_error "github.com/rezamirz/myalgos/error"
+	"github.com/stretchr/testify/assert"
+)
+
+type maxFlowSolver func(net *FlowNetwork, s, t int) (*MaxFlow, error)
+
+var maxFlowSolvers = []maxFlowSolver{NewEdmondsKarp, NewDinic}
+
+// Checks the capacity and conservation constraints and that the cut matches the flow
+func assertMaxFlow(t *testing.T, net *FlowNetwork, mf *MaxFlow, s, sink int) {
+	excess := make([]float64, net.GetNumVertices())
+	cut := 0.0
+	for _, e := range net.Edges() {
+		assert.Equal(t, true, e.Flow() >= 0 && e.Flow() <= e.Capacity())
+		excess[e.From()] -= e.Flow()
+		excess[e.To()] += e.Flow()
+		if mf.InCut(e.From()) && !mf.InCut(e.To()) {
+			cut += e.Capacity()
+		}
+	}
+
+	for v, x := range excess {
+		switch v {
+		case s:
+			assert.InDelta(t, -mf.Value(), x, 1e-9)
+		case sink:
+			assert.InDelta(t, mf.Value(), x, 1e-9)
+		default:
+			assert.InDelta(t, 0, x, 1e-9)
+		}
+	}
+
+	assert.Equal(t, true, mf.InCut(s))
+	assert.Equal(t, false, mf.InCut(sink))
+	assert.InDelta(t, mf.Value(), cut, 1e-9)
+}
+
+func TestMaxFlow_TinyFN(t *testing.T) {
+	g, err := readFile("data/tinyFN.txt", true)
+	assert.NoError(t, err)
+
+	net := NewFlowNetwork(g.GetNumVertices())
+	for _, e := range g.(*EdgeWeightedDigraph).Edges() {
+		net.AddFlowEdge(NewFlowEdge(e.From(), e.To(), e.Weight()))
+	}
+	assert.Equal(t, 8, net.GetNumEdges())
+	assert.Equal(t, []int{3, 4}, net.GetNeighbors(1))
+
+	for _, solver := range maxFlowSolvers {
+		mf, err := solver(net, 0, 5)
+		assert.NoError(t, err)
+		assert.Equal(t, 4.0, mf.Value())
+		for v := 0; v < 6; v++ {
+			assert.Equal(t, v == 0 || v == 2, mf.InCut(v))
+		}
+		assertMaxFlow(t, net, mf, 0, 5)
+	}
+}
+
+func TestMaxFlow_Errors(t *testing.T) {
+	net := NewFlowNetwork(2)
+	e := NewFlowEdge(0, 1, 1)
+	net.AddFlowEdge(e)
+
+	for _, solver := range maxFlowSolvers {
+		_, err := solver(net, 0, 0)
+		assert.Equal(t, algo_error.INVALID_ARGUMENT, err)
+		_, err = solver(net, 0, 2)
+		assert.Equal(t, algo_error.INVALID_ARGUMENT, err)
+
+		mf, err := solver(net, 0, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, 1.0, mf.Value())
+		assert.Equal(t, 1.0, e.Flow())
+		assert.Equal(t, 0.0, e.ResidualCapacityTo(1))
+		assert.Equal(t, 1.0, e.ResidualCapacityTo(0))
+	}
+
+	net.AddFlowEdge(NewFlowEdge(1, 0, -1))
+	_, err := NewDinic(net, 0, 1)
+	assert.Error(t, err)
+}
+
+func TestMaxFlow_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 30; i++ {
+		n := 2 + r.Intn(15)
+		net := NewFlowNetwork(n)
+		for e := r.Intn(4 * n); e > 0; e-- {
+			net.AddFlowEdge(NewFlowEdge(r.Intn(n), r.Intn(n), float64(r.Intn(10))))
+		}
+
+		values := []float64{}
+		for _, solver := range maxFlowSolvers {
+			mf, err := solver(net, 0, n-1)
+			assert.NoError(t, err)
+			assertMaxFlow(t, net, mf, 0, n-1)
+			values = append(values, mf.Value())
+		}
+		assert.Equal(t, values[0], values[1])
+	}
+}
+
+func TestFlowNetwork_Remove(t *testing.T) {
+	net := NewFlowNetwork(4)
+	e01 := NewFlowEdge(0, 1, 2)
+	net.AddFlowEdge(e01)
+	net.AddFlowEdge(NewFlowEdge(1, 2, 3))
+	net.AddFlowEdge(NewCostFlowEdge(2, 3, 4, 1.5))
+	net.AddFlowEdge(NewFlowEdge(0, 3, 1))
+
+	assert.Equal(t, true, net.HasEdge(1, 2))
+	assert.Equal(t, false, net.HasEdge(2, 1))
+
+	rn := net.Reverse()
+	assert.Equal(t, 4, rn.GetNumEdges())
+	assert.Equal(t, []int{1}, rn.GetNeighbors(2))
+	assert.Equal(t, true, rn.HasEdge(3, 2))
+	assert.Equal(t, 1.5, rn.Adj(3)[1].Cost())
+
+	// The edge is removed from both end points
+	assert.Equal(t, true, net.RemoveEdge(1, 2))
+	assert.Equal(t, false, net.RemoveEdge(1, 2))
+	assert.Equal(t, []*FlowEdge{e01}, net.Adj(1))
+	assert.Equal(t, 1, len(net.Adj(2)))
+	assert.Equal(t, 3, net.GetNumEdges())
+
+	assert.Equal(t, []int{0, -1, 1, 2}, net.RemoveVertex(1))
+	assert.Equal(t, 3, net.GetNumVertices())
+	assert.Equal(t, 2, net.GetNumEdges())
+	assert.Equal(t, []int{2}, net.GetNeighbors(0))
+	assert.Equal(t, []int{2}, net.GetNeighbors(1))
+	assert.Nil(t, net.RemoveVertex(3))
+
+	mf, err := NewDinic(net, 0, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 1.0, mf.Value())
+}
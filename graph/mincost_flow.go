@@ -0,0 +1,190 @@
+/*
+
+mincost_flow.go
+
+MIT License
+
+Copyright (c) 2018 rezamirz
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package graph
+
+import (
+	"fmt"
+	"math"
+
+	algo_error "github.com/rezamirz/myalgos/error"
+	"github.com/rezamirz/myalgos/util"
+)
+
+// Minimum cost maximum flow from s to t in a FlowNetwork whose edges have a
+// cost per unit of flow. It augments along the cheapest paths in the residual
+// network found by Dijkstra with the reduced costs c(v, w) + p(v) - p(w), the
+// potentials p keep the reduced costs non-negative.
+type MinCostFlow struct {
+	flow      float64     // Value of the flow
+	cost      float64     // Total cost of the flow
+	potential []float64   // Potential of v
+	distTo    []float64   // Reduced cost of the cheapest path from s to v
+	edgeTo    []*FlowEdge // Last edge on the cheapest path from s to v
+}
+
+// Sets the flow of every edge of net. Returns an error wrapping NEGATIVE_CYCLE
+// if the edges with negative costs form a cycle reachable from s.
+func NewMinCostFlow(net *FlowNetwork, s, t int) (*MinCostFlow, error) {
+	if err := checkFlowNetwork(net, s, t); err != nil {
+		return nil, err
+	}
+
+	n := net.GetNumVertices()
+	mcf := &MinCostFlow{
+		potential: make([]float64, n),
+		distTo:    make([]float64, n),
+		edgeTo:    make([]*FlowEdge, n),
+	}
+
+	net.resetFlow()
+	if err := mcf.initPotential(net, s); err != nil {
+		return nil, err
+	}
+
+	for mcf.shortestPath(net, s) && mcf.distTo[t] < math.Inf(1) {
+		for v := 0; v < n; v++ {
+			if mcf.distTo[v] < math.Inf(1) {
+				mcf.potential[v] += mcf.distTo[v]
+			}
+		}
+
+		bottleneck := math.Inf(1)
+		for v := t; v != s; v = mcf.edgeTo[v].Other(v) {
+			bottleneck = math.Min(bottleneck, mcf.edgeTo[v].ResidualCapacityTo(v))
+		}
+
+		for v := t; v != s; v = mcf.edgeTo[v].Other(v) {
+			mcf.edgeTo[v].addResidualFlowTo(v, bottleneck)
+		}
+
+		mcf.flow += bottleneck
+	}
+
+	for _, e := range net.Edges() {
+		mcf.cost += e.Flow() * e.Cost()
+	}
+
+	return mcf, nil
+}
+
+// Bellman-Ford over the edges with capacity, only needed if some costs are negative
+func (mcf *MinCostFlow) initPotential(net *FlowNetwork, s int) error {
+	edges := net.Edges()
+	negative := false
+	for _, e := range edges {
+		if e.Cost() < 0 && e.Capacity() > 0 {
+			negative = true
+		}
+	}
+
+	if !negative {
+		return nil
+	}
+
+	dist := make([]float64, net.GetNumVertices())
+	for v := range dist {
+		dist[v] = math.Inf(1)
+	}
+	dist[s] = 0
+
+	for i := 0; i <= net.GetNumVertices(); i++ {
+		relaxed := false
+		for _, e := range edges {
+			if e.Capacity() <= 0 || dist[e.From()] == math.Inf(1) {
+				continue
+			}
+			if dist[e.From()]+e.Cost() < dist[e.To()] {
+				dist[e.To()] = dist[e.From()] + e.Cost()
+				relaxed = true
+			}
+		}
+
+		if !relaxed {
+			break
+		}
+
+		if i == net.GetNumVertices() {
+			return fmt.Errorf("negative cost cycle reachable from %d: %w", s, algo_error.NEGATIVE_CYCLE)
+		}
+	}
+
+	// The vertices not reachable from s stay unreachable in the residual network
+	for v, d := range dist {
+		if d < math.Inf(1) {
+			mcf.potential[v] = d
+		}
+	}
+
+	return nil
+}
+
+// Dijkstra with the reduced costs over the residual network
+func (mcf *MinCostFlow) shortestPath(net *FlowNetwork, s int) bool {
+	for v := range mcf.distTo {
+		mcf.distTo[v] = math.Inf(1)
+	}
+	mcf.distTo[s] = 0
+
+	pq := util.NewHashedPQ(util.MinPQ, net.GetNumVertices())
+	pq.Put(s, floatPriority(0))
+	for !pq.IsEmpty() {
+		key, _, err := pq.Dequeue()
+		if err != nil {
+			return false
+		}
+
+		v := key.(int)
+		for _, e := range net.adjMap[v] {
+			w := e.Other(v)
+			if e.ResidualCapacityTo(w) <= 0 {
+				continue
+			}
+
+			// Clamp the floating point errors that make a reduced cost negative
+			reduced := math.Max(0, e.ResidualCostTo(w)+mcf.potential[v]-mcf.potential[w])
+			if mcf.distTo[v]+reduced < mcf.distTo[w] {
+				mcf.distTo[w] = mcf.distTo[v] + reduced
+				mcf.edgeTo[w] = e
+				pq.Put(w, floatPriority(mcf.distTo[w]))
+			}
+		}
+	}
+
+	return true
+}
+
+// Returns the value of the maximum flow
+func (mcf *MinCostFlow) Flow() float64 {
+	return mcf.flow
+}
+
+// Returns the total cost of the flow, the minimum among the maximum flows
+func (mcf *MinCostFlow) Cost() float64 {
+	return mcf.cost
+}
@@ -0,0 +1,122 @@
+package graph
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	algo_error "github.com/rezamirz/myalgos/error"
+	"github.com/stretchr/testify/assert"
+)
+
+// Jobs 1..n and machines n+1..2n between the source 0 and the sink 2n+1
+func assignmentNetwork(costs [][]float64) (*FlowNetwork, [][]*FlowEdge) {
+	n := len(costs)
+	net := NewFlowNetwork(2*n + 2)
+	edges := make([][]*FlowEdge, n)
+	for i := 0; i < n; i++ {
+		net.AddFlowEdge(NewFlowEdge(0, 1+i, 1))
+		net.AddFlowEdge(NewFlowEdge(1+n+i, 2*n+1, 1))
+		for j := 0; j < n; j++ {
+			e := NewCostFlowEdge(1+i, 1+n+j, 1, costs[i][j])
+			net.AddFlowEdge(e)
+			edges[i] = append(edges[i], e)
+		}
+	}
+
+	return net, edges
+}
+
+// Returns the cheapest assignment by trying all the permutations
+func bruteForceAssignment(costs [][]float64) float64 {
+	n := len(costs)
+	best := math.Inf(1)
+	used := make([]bool, n)
+
+	var assign func(i int, cost float64)
+	assign = func(i int, cost float64) {
+		if i == n {
+			best = math.Min(best, cost)
+			return
+		}
+		for j := 0; j < n; j++ {
+			if !used[j] {
+				used[j] = true
+				assign(i+1, cost+costs[i][j])
+				used[j] = false
+			}
+		}
+	}
+
+	assign(0, 0)
+	return best
+}
+
+func TestMinCostFlow_Assignment(t *testing.T) {
+	costs := [][]float64{
+		{4, 1, 3},
+		{2, 0, 5},
+		{3, 2, 2},
+	}
+
+	net, edges := assignmentNetwork(costs)
+	mcf, err := NewMinCostFlow(net, 0, 7)
+	assert.NoError(t, err)
+	assert.Equal(t, 3.0, mcf.Flow())
+	assert.Equal(t, 5.0, mcf.Cost())
+
+	// Job 0 -> machine 1, job 1 -> machine 0, job 2 -> machine 2
+	assert.Equal(t, 1.0, edges[0][1].Flow())
+	assert.Equal(t, 1.0, edges[1][0].Flow())
+	assert.Equal(t, 1.0, edges[2][2].Flow())
+	assert.Equal(t, 0.0, edges[0][0].Flow())
+}
+
+func TestMinCostFlow_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 30; i++ {
+		n := 1 + r.Intn(5)
+		costs := make([][]float64, n)
+		for j := range costs {
+			for k := 0; k < n; k++ {
+				costs[j] = append(costs[j], float64(r.Intn(21)-5))
+			}
+		}
+
+		net, _ := assignmentNetwork(costs)
+		mcf, err := NewMinCostFlow(net, 0, 2*n+1)
+		assert.NoError(t, err)
+		assert.Equal(t, float64(n), mcf.Flow())
+		assert.InDelta(t, bruteForceAssignment(costs), mcf.Cost(), 1e-9)
+
+		mf, err := NewDinic(net, 0, 2*n+1)
+		assert.NoError(t, err)
+		assert.Equal(t, mf.Value(), mcf.Flow())
+	}
+}
+
+func TestMinCostFlow_ParallelPaths(t *testing.T) {
+	// The cheap path has capacity 2, the rest goes through the expensive one
+	net := NewFlowNetwork(4)
+	net.AddFlowEdge(NewCostFlowEdge(0, 1, 2, 1))
+	net.AddFlowEdge(NewCostFlowEdge(1, 3, 5, 1))
+	net.AddFlowEdge(NewCostFlowEdge(0, 2, 5, 3))
+	net.AddFlowEdge(NewCostFlowEdge(2, 3, 2, 3))
+	net.AddFlowEdge(NewCostFlowEdge(2, 1, 5, 0))
+
+	mcf, err := NewMinCostFlow(net, 0, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, 7.0, mcf.Flow())
+	assert.Equal(t, 28.0, mcf.Cost())
+}
+
+func TestMinCostFlow_NegativeCycle(t *testing.T) {
+	net := NewFlowNetwork(3)
+	net.AddFlowEdge(NewCostFlowEdge(0, 1, 1, 1))
+	net.AddFlowEdge(NewCostFlowEdge(1, 2, 1, -3))
+	net.AddFlowEdge(NewCostFlowEdge(2, 1, 1, 1))
+
+	_, err := NewMinCostFlow(net, 0, 2)
+	assert.Equal(t, true, errors.Is(err, algo_error.NEGATIVE_CYCLE))
+}
@@ -0,0 +1,244 @@
+/*
+
+mst.go
+
+MIT License
+
+Copyright (c) 2018 rezamirz
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package graph
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/rezamirz/myalgos/util"
+)
+
+// Minimum spanning tree of an edge weighted graph. If the graph is not
+// connected it is the minimum spanning forest, one tree per component.
+type MST interface {
+	Edges() []Edge   // Edges of the minimum spanning tree
+	Weight() float64 // Sum of the weights of the edges
+}
+
+type MSTType int
+
+const (
+	Kruskal MSTType = iota + 1
+	LazyPrim
+	EagerPrim
+)
+
+func NewMST(g *EdgeWeightedGraph, mstType MSTType) MST {
+	switch mstType {
+	case Kruskal:
+		return newKruskalMST(g)
+	case LazyPrim:
+		return newLazyPrimMST(g)
+	case EagerPrim:
+		return newEagerPrimMST(g)
+	}
+
+	return nil
+}
+
+type mstEdges struct {
+	edges  []Edge
+	weight float64
+}
+
+func (mst *mstEdges) add(e Edge) {
+	mst.edges = append(mst.edges, e)
+	mst.weight += e.Weight()
+}
+
+func (mst *mstEdges) Edges() []Edge {
+	edges := make([]Edge, len(mst.edges))
+	copy(edges, mst.edges)
+	return edges
+}
+
+func (mst *mstEdges) Weight() float64 {
+	return mst.weight
+}
+
+// Compares the weights of two edges for util.Heap
+type edgeComparator struct{}
+
+func (c *edgeComparator) Compare(k1, k2 interface{}) int {
+	e1, ok := k1.(Edge)
+	if !ok {
+		panic(fmt.Errorf("Type assersion failed in edgeComparator %T", k1))
+	}
+
+	e2, ok := k2.(Edge)
+	if !ok {
+		panic(fmt.Errorf("Type assersion failed in edgeComparator %T", k2))
+	}
+
+	if e1.Weight() > e2.Weight() {
+		return 1
+	} else if e1.Weight() < e2.Weight() {
+		return -1
+	}
+
+	return 0
+}
+
+// Kruskal's algorithm adds the edges in increasing order of weight unless
+// they connect two vertices that are already connected.
+type KruskalMST struct {
+	mstEdges
+}
+
+func newKruskalMST(g *EdgeWeightedGraph) *KruskalMST {
+	mst := &KruskalMST{}
+
+	edges := g.Edges()
+	heap := util.NewHeap(uint32(len(edges)+2), &edgeComparator{})
+	for _, e := range edges {
+		heap.Put(e)
+	}
+
+	uf := util.NewUF(g.GetNumVertices())
+	for !heap.IsEmpty() && len(mst.edges) < g.GetNumVertices()-1 {
+		e := heap.DeleteTop().(Edge)
+		v := e.Either()
+		w := e.Other(v)
+		if connected, _ := uf.Connected(v, w); connected {
+			continue
+		}
+		uf.Union(v, w)
+		mst.add(e)
+	}
+
+	return mst
+}
+
+// Lazy version of Prim's algorithm keeps the edges that became ineligible in
+// the priority queue and skips them when they are removed.
+type LazyPrimMST struct {
+	mstEdges
+	marked []bool     // Is v on the tree
+	heap   util.IHeap // Edges with at least one end point on the tree
+}
+
+func newLazyPrimMST(g *EdgeWeightedGraph) *LazyPrimMST {
+	mst := &LazyPrimMST{
+		marked: make([]bool, g.GetNumVertices()),
+		heap:   util.NewHeap(uint32(g.GetNumEdges()+2), &edgeComparator{}),
+	}
+
+	for v := 0; v < g.GetNumVertices(); v++ {
+		if !mst.marked[v] {
+			mst.prim(g, v)
+		}
+	}
+
+	return mst
+}
+
+func (mst *LazyPrimMST) prim(g *EdgeWeightedGraph, s int) {
+	mst.visit(g, s)
+	for !mst.heap.IsEmpty() {
+		e := mst.heap.DeleteTop().(Edge)
+		v := e.Either()
+		w := e.Other(v)
+		if mst.marked[v] && mst.marked[w] {
+			continue
+		}
+
+		mst.add(e)
+		if !mst.marked[v] {
+			mst.visit(g, v)
+		}
+		if !mst.marked[w] {
+			mst.visit(g, w)
+		}
+	}
+}
+
+func (mst *LazyPrimMST) visit(g *EdgeWeightedGraph, v int) {
+	mst.marked[v] = true
+	for _, e := range g.Adj(v) {
+		if !mst.marked[e.Other(v)] {
+			mst.heap.Put(e)
+		}
+	}
+}
+
+// Eager version of Prim's algorithm keeps only the lightest edge connecting
+// each vertex to the tree in the priority queue.
+type EagerPrimMST struct {
+	mstEdges
+	edgeTo []Edge    // Lightest edge connecting v to the tree
+	distTo []float64 // Weight of edgeTo[v]
+	marked []bool    // Is v on the tree
+	pq     util.PriorityQueue
+}
+
+func newEagerPrimMST(g *EdgeWeightedGraph) *EagerPrimMST {
+	n := g.GetNumVertices()
+	mst := &EagerPrimMST{
+		edgeTo: make([]Edge, n),
+		distTo: make([]float64, n),
+		marked: make([]bool, n),
+		pq:     util.NewHashedPQ(util.MinPQ, n),
+	}
+
+	for v := range mst.distTo {
+		mst.distTo[v] = math.Inf(1)
+	}
+
+	for v := 0; v < n; v++ {
+		if !mst.marked[v] {
+			mst.prim(g, v)
+		}
+	}
+
+	return mst
+}
+
+func (mst *EagerPrimMST) prim(g *EdgeWeightedGraph, s int) {
+	mst.distTo[s] = 0
+	mst.pq.Put(s, floatPriority(0))
+	for !mst.pq.IsEmpty() {
+		key, _, _ := mst.pq.Dequeue()
+		v := key.(int)
+		mst.marked[v] = true
+		if v != s {
+			mst.add(mst.edgeTo[v])
+		}
+
+		for _, e := range g.Adj(v) {
+			w := e.Other(v)
+			if mst.marked[w] || e.Weight() >= mst.distTo[w] {
+				continue
+			}
+			mst.edgeTo[w] = e
+			mst.distTo[w] = e.Weight()
+			mst.pq.Put(w, floatPriority(e.Weight()))
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMST_TinyEWG(t *testing.T) {
+	g, err := readFile("data/tinyEWG.txt", false)
+	assert.NoError(t, err)
+
+	expected := []Edge{
+		NewEdge(0, 7, 0.16),
+		NewEdge(2, 3, 0.17),
+		NewEdge(1, 7, 0.19),
+		NewEdge(0, 2, 0.26),
+		NewEdge(5, 7, 0.28),
+		NewEdge(4, 5, 0.35),
+		NewEdge(6, 2, 0.40),
+	}
+
+	for _, mstType := range []MSTType{Kruskal, LazyPrim, EagerPrim} {
+		mst := NewMST(g.(*EdgeWeightedGraph), mstType)
+		assert.ElementsMatch(t, expected, mst.Edges())
+		assert.InDelta(t, 1.81, mst.Weight(), 1e-9)
+	}
+
+	assert.Nil(t, NewMST(g.(*EdgeWeightedGraph), MSTType(0)))
+}
+
+func TestMST_Forest(t *testing.T) {
+	g := NewEdgeWeightedGraph(6)
+	g.AddWeightedEdge(NewEdge(0, 1, 3))
+	g.AddWeightedEdge(NewEdge(1, 2, 1))
+	g.AddWeightedEdge(NewEdge(0, 2, 2))
+	g.AddWeightedEdge(NewEdge(3, 4, 5))
+	g.AddWeightedEdge(NewEdge(4, 4, 0.5))
+	g.AddWeightedEdge(NewEdge(3, 4, 4))
+
+	expected := []Edge{
+		NewEdge(1, 2, 1),
+		NewEdge(0, 2, 2),
+		NewEdge(3, 4, 4),
+	}
+
+	for _, mstType := range []MSTType{Kruskal, LazyPrim, EagerPrim} {
+		mst := NewMST(g, mstType)
+		assert.ElementsMatch(t, expected, mst.Edges())
+		assert.Equal(t, 7.0, mst.Weight())
+	}
+}
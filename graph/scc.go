@@ -0,0 +1,234 @@
+/*
+
+scc.go
+
+MIT License
+
+Copyright (c) 2018 rezamirz
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package graph
+
+type SCCType int
+
+const (
+	KosarajuSCC SCCType = iota + 1
+	TarjanSCC
+)
+
+// Strongly connected components of a directed graph
+//
+// With both algorithms the components are numbered in reverse topological
+// order of the condensation, i.e. no edge leaves component 0.
+type SCC struct {
+	g       Graph
+	count   int     // Number of strongly connected components
+	id      []int   // Component id of v
+	members [][]int // Vertices of each component
+}
+
+func NewSCC(g Graph, sccType SCCType) *SCC {
+	scc := &SCC{
+		g:  g,
+		id: make([]int, g.GetNumVertices()),
+	}
+
+	switch sccType {
+	case KosarajuSCC:
+		scc.kosaraju()
+	case TarjanSCC:
+		scc.tarjan()
+	default:
+		return nil
+	}
+
+	scc.members = make([][]int, scc.count)
+	for v, id := range scc.id {
+		scc.members[id] = append(scc.members[id], v)
+	}
+
+	return scc
+}
+
+// Kosaraju-Sharir: depth first search on g in the reverse postorder of the reverse of g
+func (scc *SCC) kosaraju() {
+	n := scc.g.GetNumVertices()
+	rg := reverseGraph(scc.g)
+
+	marked := make([]bool, n)
+	postorder := make([]int, 0, n)
+
+	var reverseSearch func(v int)
+	reverseSearch = func(v int) {
+		marked[v] = true
+		for _, w := range neighbors(rg, v) {
+			if !marked[w] {
+				reverseSearch(w)
+			}
+		}
+		postorder = append(postorder, v)
+	}
+
+	for v := 0; v < n; v++ {
+		if !marked[v] {
+			reverseSearch(v)
+		}
+	}
+
+	marked = make([]bool, n)
+
+	var doSearch func(v int)
+	doSearch = func(v int) {
+		marked[v] = true
+		scc.id[v] = scc.count
+		for _, w := range neighbors(scc.g, v) {
+			if !marked[w] {
+				doSearch(w)
+			}
+		}
+	}
+
+	for i := n - 1; i >= 0; i-- {
+		v := postorder[i]
+		if !marked[v] {
+			doSearch(v)
+			scc.count++
+		}
+	}
+}
+
+// Tarjan: a single depth first search keeping the lowest preorder number reachable from v
+func (scc *SCC) tarjan() {
+	n := scc.g.GetNumVertices()
+	marked := make([]bool, n)
+	low := make([]int, n)
+	stack := []int{}
+	pre := 0
+
+	var doSearch func(v int)
+	doSearch = func(v int) {
+		marked[v] = true
+		low[v] = pre
+		pre++
+		min := low[v]
+		stack = append(stack, v)
+
+		for _, w := range neighbors(scc.g, v) {
+			if !marked[w] {
+				doSearch(w)
+			}
+			if low[w] < min {
+				min = low[w]
+			}
+		}
+
+		if min < low[v] {
+			low[v] = min
+			return
+		}
+
+		// v is the root of a component, pop the component from the stack
+		for {
+			w := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			scc.id[w] = scc.count
+			low[w] = n
+			if w == v {
+				break
+			}
+		}
+		scc.count++
+	}
+
+	for v := 0; v < n; v++ {
+		if !marked[v] {
+			doSearch(v)
+		}
+	}
+}
+
+// Returns the number of strongly connected components
+func (scc *SCC) Count() int {
+	return scc.count
+}
+
+// Returns the component id of v
+func (scc *SCC) ID(v int) int {
+	return scc.id[v]
+}
+
+func (scc *SCC) StronglyConnected(v, w int) bool {
+	return scc.id[v] == scc.id[w]
+}
+
+// Returns the vertices of the component id
+func (scc *SCC) Members(id int) []int {
+	members := make([]int, len(scc.members[id]))
+	copy(members, scc.members[id])
+	return members
+}
+
+// Returns the vertices of every component indexed by the component id
+func (scc *SCC) Components() [][]int {
+	components := make([][]int, scc.count)
+	for id := range components {
+		components[id] = scc.Members(id)
+	}
+
+	return components
+}
+
+// Returns the DAG with a vertex for each component and an edge between two
+// components if there is any edge between their vertices.
+func (scc *SCC) Condensation() Graph {
+	dag := NewDGraph(scc.count)
+	added := map[[2]int]bool{}
+
+	for v := 0; v < scc.g.GetNumVertices(); v++ {
+		for _, w := range neighbors(scc.g, v) {
+			edge := [2]int{scc.id[v], scc.id[w]}
+			if edge[0] == edge[1] || added[edge] {
+				continue
+			}
+			added[edge] = true
+			dag.AddEdge(edge[0], edge[1])
+		}
+	}
+
+	return dag
+}
+
+// Returns a DGraph with all the edges of g reversed
+func reverseGraph(g Graph) Graph {
+	if dg, ok := g.(*DGraph); ok {
+		return dg.Reverse()
+	}
+
+	rg := NewDGraph(g.GetNumVertices())
+	for v := 0; v < g.GetNumVertices(); v++ {
+		for _, w := range neighbors(g, v) {
+			rg.AddEdge(w, v)
+		}
+	}
+
+	return rg
+}
@@ -0,0 +1,51 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSCC_TinyDG(t *testing.T) {
+	g, err := readFile("data/tinyDG.txt", true)
+	assert.NoError(t, err)
+
+	for _, sccType := range []SCCType{KosarajuSCC, TarjanSCC} {
+		scc := NewSCC(g, sccType)
+		assert.Equal(t, 5, scc.Count())
+
+		components := scc.Components()
+		assert.ElementsMatch(t, [][]int{
+			{1},
+			{0, 2, 3, 4, 5},
+			{9, 10, 11, 12},
+			{6, 8},
+			{7},
+		}, components)
+
+		for id, members := range components {
+			for _, v := range members {
+				assert.Equal(t, id, scc.ID(v))
+			}
+		}
+
+		assert.Equal(t, true, scc.StronglyConnected(0, 4))
+		assert.Equal(t, true, scc.StronglyConnected(6, 8))
+		assert.Equal(t, false, scc.StronglyConnected(6, 7))
+		assert.Equal(t, false, scc.StronglyConnected(0, 1))
+
+		// Component 0 is a sink and the condensation is a DAG
+		dag := scc.Condensation()
+		assert.Equal(t, 5, dag.GetNumVertices())
+		assert.Nil(t, dag.GetNeighbors(0))
+		assert.Equal(t, false, NewDirectedCycle(dag).HasCycle())
+
+		top, err := NewTopological(dag)
+		assert.NoError(t, err)
+		assert.Equal(t, scc.ID(7), top.Order()[0])
+	}
+}
+
+func TestSCC_Unknown(t *testing.T) {
+	assert.Nil(t, NewSCC(NewDGraph(1), SCCType(0)))
+}
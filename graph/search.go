@@ -33,7 +33,8 @@ import (
 )
 
 type Search interface {
-	DoSearch(g Graph, start, end int) // Search from start to end
+	DoSearch(g Graph, start, end int) // Search from start to end, a negative end searches all the nodes connected to start
+	Found() bool                      // Was end reached by the search
 	Count() int                       // Number of connected nodes to start
 	PathTo(v int) []int               // Path to node v from start
 }
@@ -44,6 +45,8 @@ const (
 	Uknown SearchType = iota
 	DepthFirstSearch
 	BreathFirstSearch
+	BidirectionalSearch
+	RecursiveDepthFirstSearch
 )
 
 func NewSearch(searchType SearchType) Search {
@@ -52,35 +55,111 @@ func NewSearch(searchType SearchType) Search {
 		return &DFS{}
 	case BreathFirstSearch:
 		return &BFS{}
+	case BidirectionalSearch:
+		return &BidirectionalBFS{}
+	case RecursiveDepthFirstSearch:
+		return &RecursiveDFS{}
 	}
 
 	return nil
 }
 
+// Depth first search with an explicit stack, it visits the nodes in the same
+// order as RecursiveDFS without growing the goroutine stack.
 type DFS struct {
 	start  int    // Start node for the search
 	count  int    // Number of nodes connected to the start
+	found  bool   // Is the end node reached
 	marked []bool // is the node already marked / visited
 	pathTo []int  // Path to node v from start
 }
 
+// A node on the DFS stack and the position of the next neighbor to explore
+type dfsFrame struct {
+	v         int
+	neighbors []int
+	next      int
+}
+
 func (dfs *DFS) DoSearch(g Graph, start, end int) {
+	dfs.init(g, start)
+	dfs.found = dfs.doSearch(g, start, end)
+}
+
+func (dfs *DFS) init(g Graph, start int) {
 	dfs.marked = make([]bool, g.GetNumVertices())
 	dfs.pathTo = make([]int, g.GetNumVertices())
 	dfs.start = start
-	dfs.doSearch(g, start, end)
+	dfs.count = 0
 }
 
-func (dfs *DFS) doSearch(g Graph, start, end int) {
+// Returns true as soon as end is reached
+func (dfs *DFS) doSearch(g Graph, start, end int) bool {
 	dfs.count++
 	dfs.marked[start] = true
+	if start == end {
+		return true
+	}
+
+	stack := []dfsFrame{{v: start, neighbors: neighbors(g, start)}}
+	for len(stack) > 0 {
+		top := &stack[len(stack)-1]
+		if top.next == len(top.neighbors) {
+			stack = stack[:len(stack)-1]
+			continue
+		}
+
+		v := top.neighbors[top.next]
+		top.next++
+		if dfs.marked[v] {
+			continue
+		}
+
+		dfs.pathTo[v] = top.v
+		dfs.count++
+		dfs.marked[v] = true
+		if v == end {
+			return true
+		}
+		stack = append(stack, dfsFrame{v: v, neighbors: neighbors(g, v)})
+	}
 
-	for _, v := range g.GetNeighbors(start) {
+	return false
+}
+
+// Depth first search with recursion, the depth of the recursion can be as
+// large as the number of nodes.
+type RecursiveDFS struct {
+	DFS
+}
+
+func (dfs *RecursiveDFS) DoSearch(g Graph, start, end int) {
+	dfs.init(g, start)
+	dfs.found = dfs.doSearch(g, start, end)
+}
+
+// Returns true as soon as end is reached
+func (dfs *RecursiveDFS) doSearch(g Graph, start, end int) bool {
+	dfs.count++
+	dfs.marked[start] = true
+	if start == end {
+		return true
+	}
+
+	for _, v := range neighbors(g, start) {
 		if !dfs.marked[v] {
 			dfs.pathTo[v] = start
-			dfs.doSearch(g, v, end)
+			if dfs.doSearch(g, v, end) {
+				return true
+			}
 		}
 	}
+
+	return false
+}
+
+func (dfs *DFS) Found() bool {
+	return dfs.found
 }
 
 func (dfs *DFS) Count() int {
@@ -105,40 +184,102 @@ func (dfs *DFS) PathTo(v int) []int {
 	return path
 }
 
+// Breadth first search from one or more sources, it finds the paths with the
+// fewest edges to the sources.
 type BFS struct {
-	q *util.Queue
-	start  int    // Start node for the search
+	q      *util.Queue
 	count  int    // Number of nodes connected to the start
+	found  bool   // Is the end node reached
 	marked []bool // is the node already marked / visited
 	pathTo []int  // Path to node v from start
+	distTo []int  // Number of edges on the shortest path to v
+	source []int  // The source on the shortest path to v
 }
 
 func (bfs *BFS) DoSearch(g Graph, start, end int) {
-	bfs.start = start
+	bfs.doSearch(g, []int{start}, end)
+}
+
+// Searches from all the sources at once, every node reached by the search is
+// connected to its nearest source.
+func (bfs *BFS) DoSearchMulti(g Graph, sources []int) {
+	bfs.doSearch(g, sources, -1)
+}
+
+func (bfs *BFS) doSearch(g Graph, sources []int, end int) {
 	bfs.q = util.NewQueue()
 	bfs.marked = make([]bool, g.GetNumVertices())
 	bfs.pathTo = make([]int, g.GetNumVertices())
+	bfs.distTo = make([]int, g.GetNumVertices())
+	bfs.source = make([]int, g.GetNumVertices())
+	bfs.count = 0
+	bfs.found = false
+
+	for _, s := range sources {
+		if bfs.marked[s] {
+			continue
+		}
+		bfs.count++
+		bfs.marked[s] = true
+		bfs.source[s] = s
+		if s == end {
+			bfs.found = true
+			return
+		}
+		bfs.q.Push(s)
+	}
 
-	bfs.q.Push(start)
 	for bfs.q.Len() > 0 {
 		v := bfs.q.Pop().(int)
-		neighbors := g.GetNeighbors(v)
-		for _, w := range neighbors {
+		for _, w := range neighbors(g, v) {
 			if bfs.marked[w] {
 				continue
 			}
 			bfs.count++
 			bfs.marked[w] = true
 			bfs.pathTo[w] = v
+			bfs.distTo[w] = bfs.distTo[v] + 1
+			bfs.source[w] = bfs.source[v]
+			if w == end {
+				bfs.found = true
+				return
+			}
 			bfs.q.Push(w)
 		}
 	}
 }
 
+func (bfs *BFS) Found() bool {
+	return bfs.found
+}
+
 func (bfs *BFS) Count() int {
 	return bfs.count
 }
 
+func (bfs *BFS) HasPathTo(v int) bool {
+	return bfs.marked[v]
+}
+
+// Returns the number of edges on the shortest path to v, -1 if there is no path
+func (bfs *BFS) DistTo(v int) int {
+	if !bfs.marked[v] {
+		return -1
+	}
+
+	return bfs.distTo[v]
+}
+
+// Returns the source nearest to v, -1 if there is no path
+func (bfs *BFS) Source(v int) int {
+	if !bfs.marked[v] {
+		return -1
+	}
+
+	return bfs.source[v]
+}
+
+// Returns the shortest path to v from its nearest source
 func (bfs *BFS) PathTo(v int) []int {
 	if !bfs.marked[v] {
 		return nil
@@ -147,7 +288,7 @@ func (bfs *BFS) PathTo(v int) []int {
 	path := []int{}
 	for {
 		path = append(path, v)
-		if v == bfs.start {
+		if bfs.distTo[v] == 0 {
 			break
 		}
 		v = bfs.pathTo[v]
@@ -157,6 +298,122 @@ func (bfs *BFS) PathTo(v int) []int {
 	return path
 }
 
+// Breadth first search from both start and end that stops when the two
+// searches meet. The search from end follows the edges backward, for a
+// directed graph it builds the reverse graph first. PathTo() returns the
+// shortest path to end and the paths to the nodes reached from start.
+type BidirectionalBFS struct {
+	start  int       // Start node for the search
+	end    int       // End node for the search
+	count  int       // Number of nodes reached by either search
+	found  bool      // Is the end node reached
+	meet   int       // Node where the two searches met
+	marked [2][]bool // is the node visited from start / end
+	pathTo [2][]int  // Path to node v from start / end
+}
+
+func (bbfs *BidirectionalBFS) DoSearch(g Graph, start, end int) {
+	n := g.GetNumVertices()
+	bbfs.start = start
+	bbfs.end = end
+	bbfs.found = false
+	bbfs.marked = [2][]bool{make([]bool, n), make([]bool, n)}
+	bbfs.pathTo = [2][]int{make([]int, n), make([]int, n)}
+
+	bbfs.count = 1
+	bbfs.marked[0][start] = true
+	if start == end {
+		bbfs.found = true
+		bbfs.meet = start
+		return
+	}
+
+	graphs := [2]Graph{g, g}
+	frontiers := [2][]int{{start}, nil}
+	if end >= 0 {
+		if isDirected(g) {
+			graphs[1] = reverseGraph(g)
+		}
+		bbfs.count++
+		bbfs.marked[1][end] = true
+		frontiers[1] = []int{end}
+	}
+
+	for len(frontiers[0]) > 0 && (end < 0 || len(frontiers[1]) > 0) {
+		// Expand one level of the smaller frontier
+		side := 0
+		if end >= 0 && len(frontiers[1]) < len(frontiers[0]) {
+			side = 1
+		}
+		other := 1 - side
+
+		next := []int{}
+		for _, v := range frontiers[side] {
+			for _, w := range neighbors(graphs[side], v) {
+				if bbfs.marked[side][w] {
+					continue
+				}
+				bbfs.marked[side][w] = true
+				bbfs.pathTo[side][w] = v
+				if bbfs.marked[other][w] {
+					bbfs.found = true
+					bbfs.meet = w
+					return
+				}
+				bbfs.count++
+				next = append(next, w)
+			}
+		}
+		frontiers[side] = next
+	}
+}
+
+func (bbfs *BidirectionalBFS) Found() bool {
+	return bbfs.found
+}
+
+func (bbfs *BidirectionalBFS) Count() int {
+	return bbfs.count
+}
+
+func (bbfs *BidirectionalBFS) PathTo(v int) []int {
+	if bbfs.found && v == bbfs.end {
+		path := bbfs.pathFrom(0, bbfs.meet)
+		reverse(path)
+		if bbfs.meet != bbfs.end {
+			path = append(path, bbfs.pathFrom(1, bbfs.pathTo[1][bbfs.meet])...)
+		}
+		return path
+	}
+
+	if !bbfs.marked[0][v] {
+		return nil
+	}
+
+	path := bbfs.pathFrom(0, v)
+	reverse(path)
+	return path
+}
+
+// Returns the nodes from v back to the start of the search of side
+func (bbfs *BidirectionalBFS) pathFrom(side, v int) []int {
+	root := bbfs.start
+	if side == 1 {
+		root = bbfs.end
+	}
+
+	path := []int{}
+	for {
+		path = append(path, v)
+		if v == root {
+			break
+		}
+		v = bbfs.pathTo[side][v]
+	}
+
+	return path
+}
+
 func reverse(arr []int) {
 	for i, j := 0, len(arr)-1; i < j; i, j = i+1, j-1 {
 		arr[i], arr[j] = arr[j], arr[i]
@@ -1,67 +1,13 @@
 package graph
 
 import (
-	"bufio"
-	"os"
-	"strconv"
-	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 )
 
-func Load(filename string) (Graph, error) {
-	f, err := os.Open(filename)
-	if err != nil {
-		return nil, err
-	}
-
-	defer f.Close()
-
-	lineNo := 0
-	nVertices := 0
-
-	var g Graph
-
-	scanner := bufio.NewScanner(f)
-	scanner.Split(bufio.ScanLines)
-	for scanner.Scan() {
-		s := scanner.Text()
-		lineNo++
-		switch lineNo {
-		case 1:
-			nVertices, err = strconv.Atoi(s)
-			if err != nil {
-				return nil, err
-			}
-			g = NewDGraph(nVertices)
-		case 2:
-			// Read nEdges
-			_, err = strconv.Atoi(s)
-			if err != nil {
-				return nil, err
-			}
-		default:
-			edges := strings.Split(s, " ")
-			v, err := strconv.Atoi(edges[0])
-			if err != nil {
-				return nil, err
-			}
-
-			w, err := strconv.Atoi(edges[1])
-			if err != nil {
-				return nil, err
-			}
-
-			g.AddEdge(v, w)
-		}
-	}
-	//fmt.Printf("Load V=%d g.V=%d, E=%d, g.E=%d\n", nVertices, g.GetNumVertices(), nEdges, g.GetNumEdges())
-	return g, nil
-}
-
 func TestDFS_SmallGraph(t *testing.T) {
-	g, err := Load("data/tinyG.txt")
+	g, err := readFile("data/tinyG.txt", true)
 	assert.NoError(t, err)
 	assert.Equal(t, 6, g.GetNumVertices())
 	assert.Equal(t, 8, g.GetNumEdges())
@@ -75,7 +21,7 @@ func TestDFS_SmallGraph(t *testing.T) {
 
 
 func TestBFS_SmallGraph(t *testing.T) {
-	g, err := Load("data/tinyG.txt")
+	g, err := readFile("data/tinyG.txt", true)
 	assert.NoError(t, err)
 	assert.Equal(t, 6, g.GetNumVertices())
 	assert.Equal(t, 8, g.GetNumEdges())
@@ -85,4 +31,153 @@ func TestBFS_SmallGraph(t *testing.T) {
 	bfs.DoSearch(g, 0, 3)
 	path := bfs.PathTo(3)
 	assert.Equal(t, 3, len(path))
+
+	// Count includes the start vertex
+	bfs.DoSearch(g, 0, -1)
+	assert.Equal(t, 6, bfs.Count())
+	bfs.DoSearch(g, 3, -1)
+	assert.Equal(t, 3, bfs.Count())
+	bfs.DoSearch(g, 4, -1)
+	assert.Equal(t, 1, bfs.Count())
+}
+
+func pathGraph(n int) Graph {
+	g := NewDGraph(n)
+	for v := 0; v+1 < n; v++ {
+		g.AddEdge(v, v+1)
+	}
+
+	return g
+}
+
+func TestSearch_EarlyTermination(t *testing.T) {
+	g := pathGraph(10)
+
+	for _, searchType := range []SearchType{DepthFirstSearch, RecursiveDepthFirstSearch, BreathFirstSearch, BidirectionalSearch} {
+		search := NewSearch(searchType)
+		search.DoSearch(g, 0, 3)
+		assert.Equal(t, true, search.Found())
+		assert.Equal(t, []int{0, 1, 2, 3}, search.PathTo(3))
+		assert.Nil(t, search.PathTo(8))
+		assert.Equal(t, true, search.Count() < 10)
+
+		// A negative end searches all the connected nodes
+		search.DoSearch(g, 0, -1)
+		assert.Equal(t, false, search.Found())
+		assert.Equal(t, 10, search.Count())
+		assert.Equal(t, 10, len(search.PathTo(9)))
+
+		// End is not reachable
+		search.DoSearch(g, 5, 2)
+		assert.Equal(t, false, search.Found())
+		assert.Nil(t, search.PathTo(2))
+
+		search.DoSearch(g, 4, 4)
+		assert.Equal(t, true, search.Found())
+		assert.Equal(t, []int{4}, search.PathTo(4))
+	}
+}
+
+func TestBidirectionalSearch(t *testing.T) {
+	g, err := readFile("data/tinyG.txt", false)
+	assert.NoError(t, err)
+
+	bbfs := NewSearch(BidirectionalSearch)
+	bbfs.DoSearch(g, 0, 3)
+	assert.Equal(t, true, bbfs.Found())
+	path := bbfs.PathTo(3)
+	assert.Equal(t, 3, len(path))
+	assert.Equal(t, 0, path[0])
+	assert.Equal(t, 3, path[2])
+
+	bbfs.DoSearch(g, 1, 4)
+	assert.Equal(t, true, bbfs.Found())
+	path = bbfs.PathTo(4)
+	assert.Equal(t, []int{1, 2, 4}, path)
+
+	g = pathGraph(101)
+	bbfs.DoSearch(g, 0, 100)
+	assert.Equal(t, true, bbfs.Found())
+	path = bbfs.PathTo(100)
+	assert.Equal(t, 101, len(path))
+	for i, v := range path {
+		assert.Equal(t, i, v)
+	}
+}
+
+func TestDFS_SameOrderAsRecursive(t *testing.T) {
+	for _, filename := range []string{"data/tinyG.txt", "data/tinyDG.txt", "data/tinyDAG.txt"} {
+		for _, directed := range []bool{true, false} {
+			g, err := readFile(filename, directed)
+			assert.NoError(t, err)
+
+			for start := 0; start < g.GetNumVertices(); start++ {
+				dfs := NewSearch(DepthFirstSearch)
+				dfs.DoSearch(g, start, -1)
+				rdfs := NewSearch(RecursiveDepthFirstSearch)
+				rdfs.DoSearch(g, start, -1)
+
+				assert.Equal(t, rdfs.Count(), dfs.Count())
+				for v := 0; v < g.GetNumVertices(); v++ {
+					assert.Equal(t, rdfs.PathTo(v), dfs.PathTo(v))
+				}
+			}
+		}
+	}
+}
+
+func TestDFS_DeepGraph(t *testing.T) {
+	const n = 1000000
+	g := pathGraph(n)
+
+	dfs := NewSearch(DepthFirstSearch)
+	dfs.DoSearch(g, 0, -1)
+	assert.Equal(t, n, dfs.Count())
+	assert.Equal(t, n, len(dfs.PathTo(n-1)))
+}
+
+func TestBFS_DistTo(t *testing.T) {
+	g, err := readFile("data/tinyG.txt", false)
+	assert.NoError(t, err)
+
+	bfs := &BFS{}
+	bfs.DoSearch(g, 0, -1)
+	assert.Equal(t, 6, bfs.Count())
+	expected := []int{0, 1, 1, 2, 2, 1}
+	for v, dist := range expected {
+		assert.Equal(t, true, bfs.HasPathTo(v))
+		assert.Equal(t, dist, bfs.DistTo(v))
+		assert.Equal(t, dist+1, len(bfs.PathTo(v)))
+		assert.Equal(t, 0, bfs.Source(v))
+	}
+
+	g = pathGraph(4)
+	bfs.DoSearch(g, 2, -1)
+	assert.Equal(t, false, bfs.HasPathTo(0))
+	assert.Equal(t, -1, bfs.DistTo(0))
+	assert.Equal(t, -1, bfs.Source(0))
+	assert.Equal(t, 1, bfs.DistTo(3))
+}
+
+func TestBFS_MultiSource(t *testing.T) {
+	g := NewUGraph(10)
+	for v := 0; v+1 < 10; v++ {
+		g.AddEdge(v, v+1)
+	}
+
+	bfs := &BFS{}
+	bfs.DoSearchMulti(g, []int{1, 7, 7})
+	assert.Equal(t, 10, bfs.Count())
+	assert.Equal(t, false, bfs.Found())
+
+	expectedDist := []int{1, 0, 1, 2, 3, 2, 1, 0, 1, 2}
+	expectedSource := []int{1, 1, 1, 1, 1, 7, 7, 7, 7, 7}
+	for v := 0; v < 10; v++ {
+		assert.Equal(t, expectedDist[v], bfs.DistTo(v))
+		assert.Equal(t, expectedSource[v], bfs.Source(v))
+	}
+
+	assert.Equal(t, []int{7, 6, 5}, bfs.PathTo(5))
+	assert.Equal(t, []int{1, 2, 3, 4}, bfs.PathTo(4))
+	assert.Equal(t, []int{7}, bfs.PathTo(7))
 }
@@ -0,0 +1,117 @@
+/*
+
+subgraph.go
+
+MIT License
+
+Copyright (c) 2018 rezamirz
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package graph
+
+// Returns the subgraph of g with the given vertices and all the edges of g
+// between them, and the original id of every vertex of the subgraph. Vertex i
+// of the subgraph is the i-th distinct vertex of vertices, the vertices that g
+// does not have are skipped. The subgraph has the same type as g for DGraph,
+// UGraph and the weighted graphs, the weights are kept; any other directed
+// graph gives a DGraph and any other undirected graph a UGraph.
+func InducedSubgraph(g Graph, vertices []int) (Graph, []int) {
+	newIDs := map[int]int{}
+	ids := []int{}
+	for _, v := range vertices {
+		if _, ok := newIDs[v]; ok || !g.HasVertex(v) {
+			continue
+		}
+		newIDs[v] = len(ids)
+		ids = append(ids, v)
+	}
+
+	switch wg := g.(type) {
+	case *EdgeWeightedDigraph:
+		sub := NewEdgeWeightedDigraph(len(ids))
+		for _, v := range ids {
+			for _, e := range wg.adjMap[v] {
+				if w, ok := newIDs[e.To()]; ok {
+					sub.AddWeightedEdge(NewDirectedEdge(newIDs[v], w, e.Weight()))
+				}
+			}
+		}
+		return sub, ids
+
+	case *EdgeWeightedGraph:
+		sub := NewEdgeWeightedGraph(len(ids))
+		for _, v := range ids {
+			for _, e := range wg.adjMap[v] {
+				w, ok := newIDs[e.Other(v)]
+				// Add an edge once, from its end point with the smaller new id
+				if ok && newIDs[v] <= w {
+					sub.AddWeightedEdge(NewEdge(newIDs[v], w, e.Weight()))
+				}
+			}
+		}
+		return sub, ids
+	}
+
+	directed := isDirected(g)
+	sub := newGraph(len(ids), directed, false)
+	for _, v := range ids {
+		for _, w := range neighbors(g, v) {
+			nw, ok := newIDs[w]
+			if ok && (directed || newIDs[v] <= nw) {
+				sub.AddEdge(newIDs[v], nw)
+			}
+		}
+	}
+
+	return sub, ids
+}
+
+// Returns the subgraph induced by the vertices at most k edges away from
+// center, following the edges forward in a directed graph, and the original id
+// of every vertex of the subgraph. The vertices are numbered in the order of a
+// breadth first search so center is vertex 0. It returns nil for a center
+// that g does not have.
+func EgoNetwork(g Graph, center, k int) (Graph, []int) {
+	if !g.HasVertex(center) {
+		return nil, nil
+	}
+
+	marked := make([]bool, g.GetNumVertices())
+	marked[center] = true
+	vertices := []int{center}
+	frontier := []int{center}
+	for depth := 0; depth < k && len(frontier) > 0; depth++ {
+		next := []int{}
+		for _, v := range frontier {
+			for _, w := range neighbors(g, v) {
+				if !marked[w] {
+					marked[w] = true
+					vertices = append(vertices, w)
+					next = append(next, w)
+				}
+			}
+		}
+		frontier = next
+	}
+
+	return InducedSubgraph(g, vertices)
+}
@@ -0,0 +1,84 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInducedSubgraph(t *testing.T) {
+	g, err := readFile("data/tinyG.txt", false)
+	assert.NoError(t, err)
+
+	// 0-5, 2-3, 3-5 and 0-2 are between the vertices, 99 is not a vertex
+	sub, ids := InducedSubgraph(g, []int{5, 0, 2, 3, 5, 99})
+	assert.Equal(t, []int{5, 0, 2, 3}, ids)
+	assert.Equal(t, false, isDirected(sub))
+	assert.Equal(t, 4, sub.GetNumVertices())
+	for v := 0; v < sub.GetNumVertices(); v++ {
+		for w := 0; w < sub.GetNumVertices(); w++ {
+			assert.Equal(t, g.(*UGraph).HasEdge(ids[v], ids[w]), sub.(*UGraph).HasEdge(v, w))
+		}
+	}
+	assert.Equal(t, 4, sub.GetNumEdges())
+
+	dg, err := readFile("data/tinyDG.txt", true)
+	assert.NoError(t, err)
+	dsub, ids := InducedSubgraph(dg, []int{0, 1, 2, 3, 4, 5})
+	assert.Equal(t, true, isDirected(dsub))
+	for v := 0; v < dsub.GetNumVertices(); v++ {
+		assert.Equal(t, 0, ids[v]-v)
+		for _, w := range dg.GetNeighbors(v) {
+			if w <= 5 {
+				assert.Equal(t, true, dsub.(*DGraph).HasEdge(v, w))
+			}
+		}
+	}
+}
+
+func TestInducedSubgraph_Weighted(t *testing.T) {
+	g := NewEdgeWeightedGraph(4)
+	g.AddWeightedEdge(NewEdge(0, 1, 0.5))
+	g.AddWeightedEdge(NewEdge(1, 2, 1.5))
+	g.AddWeightedEdge(NewEdge(2, 2, 2.5))
+	g.AddWeightedEdge(NewEdge(2, 3, 3.5))
+
+	sub, ids := InducedSubgraph(g, []int{2, 1})
+	assert.Equal(t, []int{2, 1}, ids)
+	assert.Equal(t, []Edge{NewEdge(0, 1, 1.5), NewEdge(0, 0, 2.5)}, sub.(*EdgeWeightedGraph).Edges())
+
+	dg := g.ToDigraph()
+	dsub, _ := InducedSubgraph(dg, []int{2, 1})
+	assert.Equal(t, []DirectedEdge{
+		NewDirectedEdge(0, 1, 1.5),
+		NewDirectedEdge(0, 0, 2.5),
+		NewDirectedEdge(1, 0, 1.5),
+	}, dsub.(*EdgeWeightedDigraph).Edges())
+}
+
+func TestEgoNetwork(t *testing.T) {
+	g := pathGraph(6)
+
+	ego, ids := EgoNetwork(g, 2, 2)
+	assert.Equal(t, []int{2, 3, 4}, ids)
+	assert.Equal(t, 2, ego.GetNumEdges())
+	assert.Equal(t, []int{1}, ego.GetNeighbors(0))
+
+	ego, ids = EgoNetwork(g, 2, 0)
+	assert.Equal(t, []int{2}, ids)
+	assert.Equal(t, 0, ego.GetNumEdges())
+
+	ug := NewUGraph(6)
+	for v := 0; v+1 < 6; v++ {
+		ug.AddEdge(v, v+1)
+	}
+	ug.AddEdge(0, 5)
+	ego, ids = EgoNetwork(ug, 0, 1)
+	assert.Equal(t, []int{0, 1, 5}, ids)
+	assert.Equal(t, 2, ego.GetNumEdges())
+	assert.Equal(t, false, isDirected(ego))
+
+	ego, ids = EgoNetwork(ug, 6, 1)
+	assert.Nil(t, ego)
+	assert.Nil(t, ids)
+}
@@ -0,0 +1,196 @@
+/*
+
+symbol_graph.go
+
+MIT License
+
+Copyright (c) 2018 rezamirz
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package graph
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	algo_error "github.com/rezamirz/myalgos/error"
+)
+
+// Graph whose vertices are identified by string names
+type SymbolGraph struct {
+	st   map[string]int // Vertex of each name
+	keys []string       // Name of each vertex
+	g    Graph
+}
+
+// Returns an empty SymbolGraph backed by a DGraph or a UGraph
+func NewSymbolGraph(directed bool) *SymbolGraph {
+	sg := &SymbolGraph{
+		st: map[string]int{},
+		g:  NewUGraph(0),
+	}
+
+	if directed {
+		sg.g = NewDGraph(0)
+	}
+
+	return sg
+}
+
+/*
+ * ReadSymbolGraph loads a graph where each line is a list of names separated
+ * by delim, e.g. "JFK ORD LAX" with delim " ". The first name of a line is
+ * connected to each of the following names. An empty delim splits the lines
+ * on any whitespace, the names are trimmed and blank lines are ignored. A
+ * line with only delimiters is an error.
+ */
+func ReadSymbolGraph(r io.Reader, delim string, directed bool) (*SymbolGraph, error) {
+	sg := NewSymbolGraph(directed)
+
+	lineNo := 0
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		lineNo++
+		var names []string
+		if delim == "" {
+			names = strings.Fields(scanner.Text())
+		} else {
+			names = strings.Split(scanner.Text(), delim)
+		}
+
+		from := ""
+		for _, name := range names {
+			name = strings.TrimSpace(name)
+			if name == "" {
+				continue
+			}
+
+			if from == "" {
+				from = name
+				sg.AddVertex(from)
+				continue
+			}
+
+			sg.g.AddEdge(sg.AddVertex(from), sg.AddVertex(name))
+		}
+
+		// A line with only delimiters has no names
+		if from == "" && strings.TrimSpace(scanner.Text()) != "" {
+			return nil, &ParseError{lineNo, fmt.Errorf("no vertex names: %w", algo_error.INVALID_ARGUMENT)}
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, &ParseError{lineNo, err}
+	}
+
+	return sg, nil
+}
+
+// Returns the vertex of name, it adds a new vertex if name is not in the graph
+func (sg *SymbolGraph) AddVertex(name string) int {
+	if v, ok := sg.st[name]; ok {
+		return v
+	}
+
+	v := sg.g.AddVertex()
+	sg.st[name] = v
+	sg.keys = append(sg.keys, name)
+	return v
+}
+
+// Adds an edge between the named vertices adding the missing vertices
+func (sg *SymbolGraph) AddEdge(from, to string) error {
+	if from == "" || to == "" {
+		return fmt.Errorf("empty vertex name: %w", algo_error.INVALID_ARGUMENT)
+	}
+
+	sg.g.AddEdge(sg.AddVertex(from), sg.AddVertex(to))
+	return nil
+}
+
+func (sg *SymbolGraph) Contains(name string) bool {
+	_, ok := sg.st[name]
+	return ok
+}
+
+// Returns the vertex of name, -1 if name is not in the graph
+func (sg *SymbolGraph) Index(name string) int {
+	v, ok := sg.st[name]
+	if !ok {
+		return -1
+	}
+
+	return v
+}
+
+// Returns the name of the vertex v
+func (sg *SymbolGraph) Name(v int) string {
+	return sg.keys[v]
+}
+
+// Returns the underlying integer indexed graph
+func (sg *SymbolGraph) Graph() Graph {
+	return sg.g
+}
+
+// Runs the search from the vertex named from to the vertex named to, an
+// empty to searches all the vertices connected to from.
+func (sg *SymbolGraph) Search(search Search, from, to string) error {
+	start := sg.Index(from)
+	if start < 0 {
+		return fmt.Errorf("unknown vertex %q: %w", from, algo_error.INVALID_ARGUMENT)
+	}
+
+	end := -1
+	if to != "" {
+		end = sg.Index(to)
+		if end < 0 {
+			return fmt.Errorf("unknown vertex %q: %w", to, algo_error.INVALID_ARGUMENT)
+		}
+	}
+
+	search.DoSearch(sg.g, start, end)
+	return nil
+}
+
+// Returns the names on the path found by search to the vertex named name
+func (sg *SymbolGraph) PathTo(search Search, name string) []string {
+	v := sg.Index(name)
+	if v < 0 {
+		return nil
+	}
+
+	path := search.PathTo(v)
+	if path == nil {
+		return nil
+	}
+
+	names := make([]string, len(path))
+	for i, w := range path {
+		names[i] = sg.keys[w]
+	}
+
+	return names
+}
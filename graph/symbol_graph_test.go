@@ -0,0 +1,84 @@
+package graph
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	algo_error "github.com/rezamirz/myalgos/error"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSymbolGraph_Routes(t *testing.T) {
+	f, err := os.Open("data/routes.txt")
+	assert.NoError(t, err)
+	defer f.Close()
+
+	sg, err := ReadSymbolGraph(f, " ", false)
+	assert.NoError(t, err)
+	assert.Equal(t, 10, sg.Graph().GetNumVertices())
+	assert.Equal(t, 18, sg.Graph().GetNumEdges())
+
+	assert.Equal(t, true, sg.Contains("JFK"))
+	assert.Equal(t, false, sg.Contains("SFO"))
+	assert.Equal(t, 0, sg.Index("JFK"))
+	assert.Equal(t, -1, sg.Index("SFO"))
+	assert.Equal(t, "MCO", sg.Name(1))
+
+	neighbors := []string{}
+	for _, w := range sg.Graph().GetNeighbors(sg.Index("JFK")) {
+		neighbors = append(neighbors, sg.Name(w))
+	}
+	assert.Equal(t, []string{"MCO", "ATL", "ORD"}, neighbors)
+
+	bfs := NewSearch(BreathFirstSearch)
+	assert.NoError(t, sg.Search(bfs, "JFK", "LAS"))
+	assert.Equal(t, true, bfs.Found())
+	assert.Equal(t, []string{"JFK", "ORD", "DEN", "LAS"}, sg.PathTo(bfs, "LAS"))
+
+	assert.NoError(t, sg.Search(bfs, "LAX", ""))
+	assert.Equal(t, 10, bfs.Count())
+	assert.Nil(t, sg.PathTo(bfs, "SFO"))
+
+	err = sg.Search(bfs, "SFO", "JFK")
+	assert.Equal(t, true, errors.Is(err, algo_error.INVALID_ARGUMENT))
+	err = sg.Search(bfs, "JFK", "SFO")
+	assert.Equal(t, true, errors.Is(err, algo_error.INVALID_ARGUMENT))
+}
+
+func TestSymbolGraph_Delimiter(t *testing.T) {
+	input := "Movie A/Actor 1/ Actor 2\n\nMovie B/Actor 2\nLonely Movie\n"
+	sg, err := ReadSymbolGraph(strings.NewReader(input), "/", true)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, sg.Graph().GetNumVertices())
+	assert.Equal(t, 3, sg.Graph().GetNumEdges())
+	assert.Equal(t, "Actor 2", sg.Name(2))
+	assert.Equal(t, true, sg.Contains("Lonely Movie"))
+
+	dfs := NewSearch(DepthFirstSearch)
+	assert.NoError(t, sg.Search(dfs, "Movie A", "Actor 2"))
+	assert.Equal(t, []string{"Movie A", "Actor 2"}, sg.PathTo(dfs, "Actor 2"))
+
+	assert.NoError(t, sg.Search(dfs, "Actor 2", ""))
+	assert.Equal(t, 1, dfs.Count())
+
+	sg = NewSymbolGraph(false)
+	assert.NoError(t, sg.AddEdge("a", "b"))
+	assert.Equal(t, 1, sg.AddVertex("b"))
+	assert.Equal(t, 2, sg.AddVertex("c"))
+	assert.Error(t, sg.AddEdge("a", ""))
+}
+
+func TestSymbolGraph_OnlyDelimiters(t *testing.T) {
+	_, err := ReadSymbolGraph(strings.NewReader("a,b\n , \nc\n"), ",", false)
+	var parseErr *ParseError
+	assert.Equal(t, true, errors.As(err, &parseErr))
+	assert.Equal(t, 2, parseErr.Line)
+	assert.Equal(t, true, errors.Is(err, algo_error.INVALID_ARGUMENT))
+
+	// Blank lines and empty names between delimiters are skipped
+	sg, err := ReadSymbolGraph(strings.NewReader("a,,b,\n\n  \n"), ",", false)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, sg.Graph().GetNumEdges())
+}
@@ -0,0 +1,146 @@
+/*
+
+topological.go
+
+MIT License
+
+Copyright (c) 2018 rezamirz
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package graph
+
+import (
+	"fmt"
+
+	algo_error "github.com/rezamirz/myalgos/error"
+	"github.com/rezamirz/myalgos/util"
+)
+
+// Topological order of a directed acyclic graph
+type Topological struct {
+	order []int // Vertices in topological order
+	rank  []int // Position of v in the order
+}
+
+// Computes the topological order as the reverse postorder of a depth first
+// search. Returns an error wrapping NOT_DAG if g has a directed cycle.
+func NewTopological(g Graph) (*Topological, error) {
+	if err := checkDAG(g); err != nil {
+		return nil, err
+	}
+
+	n := g.GetNumVertices()
+	marked := make([]bool, n)
+	postorder := make([]int, 0, n)
+
+	var doSearch func(v int)
+	doSearch = func(v int) {
+		marked[v] = true
+		for _, w := range neighbors(g, v) {
+			if !marked[w] {
+				doSearch(w)
+			}
+		}
+		postorder = append(postorder, v)
+	}
+
+	for v := 0; v < n; v++ {
+		if !marked[v] {
+			doSearch(v)
+		}
+	}
+
+	reverse(postorder)
+	return newTopological(postorder), nil
+}
+
+// Computes the topological order with Kahn's algorithm by repeatedly removing
+// the vertices with no incoming edges. Returns an error wrapping NOT_DAG if g
+// has a directed cycle.
+func NewTopologicalKahn(g Graph) (*Topological, error) {
+	n := g.GetNumVertices()
+	inDegree := make([]int, n)
+	for v := 0; v < n; v++ {
+		for _, w := range neighbors(g, v) {
+			inDegree[w]++
+		}
+	}
+
+	q := util.NewQueue()
+	for v := 0; v < n; v++ {
+		if inDegree[v] == 0 {
+			q.Push(v)
+		}
+	}
+
+	order := make([]int, 0, n)
+	for q.Len() > 0 {
+		v := q.Pop().(int)
+		order = append(order, v)
+		for _, w := range neighbors(g, v) {
+			inDegree[w]--
+			if inDegree[w] == 0 {
+				q.Push(w)
+			}
+		}
+	}
+
+	if len(order) != n {
+		return nil, checkDAG(g)
+	}
+
+	return newTopological(order), nil
+}
+
+func newTopological(order []int) *Topological {
+	t := &Topological{
+		order: order,
+		rank:  make([]int, len(order)),
+	}
+
+	for i, v := range order {
+		t.rank[v] = i
+	}
+
+	return t
+}
+
+func checkDAG(g Graph) error {
+	dc := NewDirectedCycle(g)
+	if dc.HasCycle() {
+		return fmt.Errorf("cycle %v: %w", dc.Cycle(), algo_error.NOT_DAG)
+	}
+
+	return nil
+}
+
+// Returns the vertices in topological order
+func (t *Topological) Order() []int {
+	order := make([]int, len(t.order))
+	copy(order, t.order)
+	return order
+}
+
+// Returns the position of v in the topological order
+func (t *Topological) Rank(v int) int {
+	return t.rank[v]
+}
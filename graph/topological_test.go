@@ -0,0 +1,48 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+
+	algo_error "github.com/rezamirz/myalgos/error"
+	"github.com/stretchr/testify/assert"
+)
+
+func assertTopological(t *testing.T, g Graph, top *Topological) {
+	order := top.Order()
+	assert.Equal(t, g.GetNumVertices(), len(order))
+	for i, v := range order {
+		assert.Equal(t, i, top.Rank(v))
+	}
+
+	for v := 0; v < g.GetNumVertices(); v++ {
+		for _, w := range g.GetNeighbors(v) {
+			assert.Equal(t, true, top.Rank(v) < top.Rank(w))
+		}
+	}
+}
+
+func TestTopological_DAG(t *testing.T) {
+	g, err := readFile("data/tinyDAG.txt", true)
+	assert.NoError(t, err)
+
+	top, err := NewTopological(g)
+	assert.NoError(t, err)
+	assertTopological(t, g, top)
+	assert.Equal(t, []int{8, 7, 2, 3, 0, 5, 1, 6, 9, 11, 10, 12, 4}, top.Order())
+
+	top, err = NewTopologicalKahn(g)
+	assert.NoError(t, err)
+	assertTopological(t, g, top)
+}
+
+func TestTopological_Cycle(t *testing.T) {
+	g, err := readFile("data/tinyDG.txt", true)
+	assert.NoError(t, err)
+
+	_, err = NewTopological(g)
+	assert.Equal(t, true, errors.Is(err, algo_error.NOT_DAG))
+
+	_, err = NewTopologicalKahn(g)
+	assert.Equal(t, true, errors.Is(err, algo_error.NOT_DAG))
+}
@@ -0,0 +1,212 @@
+/*
+
+traversal.go
+
+MIT License
+
+Copyright (c) 2018 rezamirz
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package graph
+
+// Classification of the edges by a depth first traversal
+type EdgeType int
+
+const (
+	TreeEdge    EdgeType = iota + 1 // Leads to a node discovered through this edge
+	BackEdge                        // Leads to an ancestor that is not finished yet
+	ForwardEdge                     // Leads to a finished descendant
+	CrossEdge                       // Leads to a finished node that is not a descendant
+)
+
+// Returned by the visitor callbacks to control the traversal
+type VisitAction int
+
+const (
+	Continue VisitAction = iota
+	Prune                // Do not explore the edges of the node / do not follow the tree edge
+	Abort                // Stop the traversal
+)
+
+// Callbacks of Traverse(). Since an undirected edge is seen from both ends,
+// the classification is meaningful only for directed graphs.
+type Visitor interface {
+	// Called when v is reached for the first time
+	DiscoverVertex(v int) VisitAction
+
+	// Called for every edge v->w of a discovered node v, before following a tree edge
+	ExamineEdge(v, w int, edgeType EdgeType) VisitAction
+
+	// Called when all the edges of v are explored, Prune has no effect
+	FinishVertex(v int) VisitAction
+}
+
+// Visitor built from functions, a nil function returns Continue
+type VisitorFuncs struct {
+	OnDiscoverVertex func(v int) VisitAction
+	OnExamineEdge    func(v, w int, edgeType EdgeType) VisitAction
+	OnFinishVertex   func(v int) VisitAction
+}
+
+func (vf *VisitorFuncs) DiscoverVertex(v int) VisitAction {
+	if vf.OnDiscoverVertex == nil {
+		return Continue
+	}
+
+	return vf.OnDiscoverVertex(v)
+}
+
+func (vf *VisitorFuncs) ExamineEdge(v, w int, edgeType EdgeType) VisitAction {
+	if vf.OnExamineEdge == nil {
+		return Continue
+	}
+
+	return vf.OnExamineEdge(v, w, edgeType)
+}
+
+func (vf *VisitorFuncs) FinishVertex(v int) VisitAction {
+	if vf.OnFinishVertex == nil {
+		return Continue
+	}
+
+	return vf.OnFinishVertex(v)
+}
+
+type traversal struct {
+	g        Graph
+	visitor  Visitor
+	pre      []int  // Discovery order of v, -1 if v is not discovered
+	finished []bool // Are all the edges of v explored
+	nPre     int    // Number of discovered nodes
+	stack    []dfsFrame
+}
+
+/*
+ * Traverse runs a depth first search from each source in order that is not
+ * discovered yet and calls the visitor on the way. If sources is nil it
+ * starts from every node of g, visiting the whole graph. The traversal uses an
+ * explicit stack and visits the nodes in the same order as DFS.
+ *
+ * Returns false if a callback aborted the traversal.
+ */
+func Traverse(g Graph, sources []int, visitor Visitor) bool {
+	n := g.GetNumVertices()
+	t := &traversal{
+		g:        g,
+		visitor:  visitor,
+		pre:      make([]int, n),
+		finished: make([]bool, n),
+	}
+
+	for v := range t.pre {
+		t.pre[v] = -1
+	}
+
+	if sources == nil {
+		sources = make([]int, n)
+		for v := range sources {
+			sources[v] = v
+		}
+	}
+
+	for _, s := range sources {
+		if t.pre[s] >= 0 {
+			continue
+		}
+		if !t.visit(s) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Returns false if the traversal is aborted
+func (t *traversal) visit(s int) bool {
+	if !t.discover(s) {
+		return false
+	}
+
+	for len(t.stack) > 0 {
+		top := &t.stack[len(t.stack)-1]
+		if top.next == len(top.neighbors) {
+			v := top.v
+			t.stack = t.stack[:len(t.stack)-1]
+			if !t.finish(v) {
+				return false
+			}
+			continue
+		}
+
+		v := top.v
+		w := top.neighbors[top.next]
+		top.next++
+
+		edgeType := t.classify(v, w)
+		action := t.visitor.ExamineEdge(v, w, edgeType)
+		if action == Abort {
+			return false
+		}
+
+		if edgeType == TreeEdge && action == Continue {
+			if !t.discover(w) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Discovers v and pushes it on the stack unless the visitor prunes it
+func (t *traversal) discover(v int) bool {
+	t.pre[v] = t.nPre
+	t.nPre++
+
+	switch t.visitor.DiscoverVertex(v) {
+	case Abort:
+		return false
+	case Prune:
+		return t.finish(v)
+	}
+
+	t.stack = append(t.stack, dfsFrame{v: v, neighbors: neighbors(t.g, v)})
+	return true
+}
+
+func (t *traversal) finish(v int) bool {
+	t.finished[v] = true
+	return t.visitor.FinishVertex(v) != Abort
+}
+
+func (t *traversal) classify(v, w int) EdgeType {
+	switch {
+	case t.pre[w] < 0:
+		return TreeEdge
+	case !t.finished[w]:
+		return BackEdge
+	case t.pre[v] < t.pre[w]:
+		return ForwardEdge
+	}
+
+	return CrossEdge
+}
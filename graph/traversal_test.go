@@ -0,0 +1,130 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type edgeRecord struct {
+	v, w     int
+	edgeType EdgeType
+}
+
+func TestTraverse_EdgeTypes(t *testing.T) {
+	g := NewDGraph(5)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 0)
+	g.AddEdge(0, 2)
+	g.AddEdge(3, 1)
+	g.AddEdge(3, 4)
+	g.AddEdge(4, 4)
+
+	discovered := []int{}
+	finished := []int{}
+	edges := []edgeRecord{}
+	completed := Traverse(g, nil, &VisitorFuncs{
+		OnDiscoverVertex: func(v int) VisitAction {
+			discovered = append(discovered, v)
+			return Continue
+		},
+		OnExamineEdge: func(v, w int, edgeType EdgeType) VisitAction {
+			edges = append(edges, edgeRecord{v, w, edgeType})
+			return Continue
+		},
+		OnFinishVertex: func(v int) VisitAction {
+			finished = append(finished, v)
+			return Continue
+		},
+	})
+
+	assert.Equal(t, true, completed)
+	assert.Equal(t, []int{0, 1, 2, 3, 4}, discovered)
+	assert.Equal(t, []int{2, 1, 0, 4, 3}, finished)
+	assert.Equal(t, []edgeRecord{
+		{0, 1, TreeEdge},
+		{1, 2, TreeEdge},
+		{2, 0, BackEdge},
+		{0, 2, ForwardEdge},
+		{3, 1, CrossEdge},
+		{3, 4, TreeEdge},
+		{4, 4, BackEdge},
+	}, edges)
+}
+
+func TestTraverse_SameOrderAsDFS(t *testing.T) {
+	g, err := readFile("data/tinyDG.txt", true)
+	assert.NoError(t, err)
+
+	count := 0
+	pathTo := make([]int, g.GetNumVertices())
+	Traverse(g, []int{0}, &VisitorFuncs{
+		OnDiscoverVertex: func(v int) VisitAction {
+			count++
+			return Continue
+		},
+		OnExamineEdge: func(v, w int, edgeType EdgeType) VisitAction {
+			if edgeType == TreeEdge {
+				pathTo[w] = v
+			}
+			return Continue
+		},
+	})
+
+	dfs := &DFS{}
+	dfs.DoSearch(g, 0, -1)
+	assert.Equal(t, dfs.Count(), count)
+	for v := 0; v < g.GetNumVertices(); v++ {
+		if dfs.PathTo(v) != nil && v != 0 {
+			assert.Equal(t, dfs.pathTo[v], pathTo[v])
+		}
+	}
+}
+
+func TestTraverse_PruneAndAbort(t *testing.T) {
+	g := pathGraph(6)
+
+	// Pruning the tree edge 1->2 and the node 4 explores 0, 1 then 3, 4 and 5
+	discovered := []int{}
+	completed := Traverse(g, []int{0, 3, 5}, &VisitorFuncs{
+		OnDiscoverVertex: func(v int) VisitAction {
+			discovered = append(discovered, v)
+			if v == 4 {
+				return Prune
+			}
+			return Continue
+		},
+		OnExamineEdge: func(v, w int, edgeType EdgeType) VisitAction {
+			if v == 1 {
+				return Prune
+			}
+			return Continue
+		},
+	})
+	assert.Equal(t, true, completed)
+	assert.Equal(t, []int{0, 1, 3, 4, 5}, discovered)
+
+	finished := []int{}
+	completed = Traverse(g, nil, &VisitorFuncs{
+		OnFinishVertex: func(v int) VisitAction {
+			finished = append(finished, v)
+			if v == 3 {
+				return Abort
+			}
+			return Continue
+		},
+	})
+	assert.Equal(t, false, completed)
+	assert.Equal(t, []int{5, 4, 3}, finished)
+
+	completed = Traverse(g, nil, &VisitorFuncs{
+		OnDiscoverVertex: func(v int) VisitAction {
+			if v == 2 {
+				return Abort
+			}
+			return Continue
+		},
+	})
+	assert.Equal(t, false, completed)
+}
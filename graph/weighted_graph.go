@@ -0,0 +1,395 @@
+/*
+
+weighted_graph.go
+
+MIT License
+
+Copyright (c) 2018 rezamirz
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+
+*/
+
+package graph
+
+// Edge weighted undirected graph
+//
+// Like UGraph, every edge is stored in the adjacency lists of both end points
+// except a self-loop which is stored once. The edges added through the Graph
+// interface by AddEdge() get weight 1.
+type EdgeWeightedGraph struct {
+	// Adjacency map of the graph nodes
+	adjMap map[int][]Edge
+
+	nVertices int
+	nEdges    int
+}
+
+func NewEdgeWeightedGraph(nVertices int) *EdgeWeightedGraph {
+	g := &EdgeWeightedGraph{
+		adjMap:    map[int][]Edge{},
+		nVertices: nVertices,
+	}
+
+	return g
+}
+
+func (g *EdgeWeightedGraph) GetNumVertices() int {
+	return g.nVertices
+}
+
+func (g *EdgeWeightedGraph) GetNumEdges() int {
+	return g.nEdges
+}
+
+func (g *EdgeWeightedGraph) AddVertex() int {
+	g.nVertices++
+	return g.nVertices - 1
+}
+
+func (g *EdgeWeightedGraph) HasVertex(v int) bool {
+	return v >= 0 && v < g.nVertices
+}
+
+// Adds the edge v-w with weight 1
+func (g *EdgeWeightedGraph) AddEdge(v, w int) {
+	g.AddWeightedEdge(NewEdge(v, w, 1))
+}
+
+func (g *EdgeWeightedGraph) AddWeightedEdge(e Edge) {
+	v := e.Either()
+	w := e.Other(v)
+	g.adjMap[v] = append(g.adjMap[v], e)
+	if v != w {
+		g.adjMap[w] = append(g.adjMap[w], e)
+	}
+	g.nEdges++
+}
+
+func (g *EdgeWeightedGraph) GetNeighbors(v int) []int {
+	edges, ok := g.adjMap[v]
+	if !ok {
+		return nil
+	}
+
+	neighbors := make([]int, len(edges))
+	for i, e := range edges {
+		neighbors[i] = e.Other(v)
+	}
+	return neighbors
+}
+
+// Returns an array containing all the edges incident to v
+func (g *EdgeWeightedGraph) Adj(v int) []Edge {
+	edges, ok := g.adjMap[v]
+	if !ok {
+		return nil
+	}
+
+	copyEdges := make([]Edge, len(edges))
+	copy(copyEdges, edges)
+	return copyEdges
+}
+
+// Returns all the edges of the graph, each edge is returned once
+func (g *EdgeWeightedGraph) Edges() []Edge {
+	edges := make([]Edge, 0, g.nEdges)
+	for v := 0; v < g.nVertices; v++ {
+		for _, e := range g.adjMap[v] {
+			if e.Other(v) >= v {
+				edges = append(edges, e)
+			}
+		}
+	}
+
+	return edges
+}
+
+// Returns the number of edges incident to v, a self-loop contributes 2
+func (g *EdgeWeightedGraph) Degree(v int) int {
+	degree := 0
+	for _, e := range g.adjMap[v] {
+		if e.Other(v) == v {
+			degree += 2
+		} else {
+			degree++
+		}
+	}
+
+	return degree
+}
+
+func (g *EdgeWeightedGraph) IsDirected() bool {
+	return false
+}
+
+// Returns true if the graph has an edge v-w
+func (g *EdgeWeightedGraph) HasEdge(v, w int) bool {
+	return edgeIndex(g.adjMap[v], v, w) >= 0
+}
+
+// Removes one edge v-w, returns false if there is no such edge
+func (g *EdgeWeightedGraph) RemoveEdge(v, w int) bool {
+	i := edgeIndex(g.adjMap[v], v, w)
+	if i < 0 {
+		return false
+	}
+
+	e := g.adjMap[v][i]
+	g.adjMap[v] = append(g.adjMap[v][:i], g.adjMap[v][i+1:]...)
+	if v != w {
+		// Remove the same edge from w so parallel edges keep their weights
+		for j, f := range g.adjMap[w] {
+			if f == e {
+				g.adjMap[w] = append(g.adjMap[w][:j], g.adjMap[w][j+1:]...)
+				break
+			}
+		}
+	}
+	g.nEdges--
+	return true
+}
+
+// Removes v and all the edges incident to it, the vertices after v move down
+// by one. Returns the new id of every old vertex, -1 for v, or nil if the
+// graph has no vertex v.
+func (g *EdgeWeightedGraph) RemoveVertex(v int) []int {
+	if !g.HasVertex(v) {
+		return nil
+	}
+
+	ids := removedVertexIDs(g.nVertices, v)
+	edges := g.Edges()
+	g.adjMap = map[int][]Edge{}
+	g.nVertices--
+	g.nEdges = 0
+	for _, e := range edges {
+		u := e.Either()
+		w := e.Other(u)
+		if u != v && w != v {
+			g.AddWeightedEdge(NewEdge(ids[u], ids[w], e.Weight()))
+		}
+	}
+
+	return ids
+}
+
+// Returns a copy of the graph since reversing an undirected edge does not change it
+func (g *EdgeWeightedGraph) Reverse() *EdgeWeightedGraph {
+	rg := &EdgeWeightedGraph{
+		adjMap:    map[int][]Edge{},
+		nVertices: g.nVertices,
+		nEdges:    g.nEdges,
+	}
+	for v, edges := range g.adjMap {
+		rg.adjMap[v] = append([]Edge(nil), edges...)
+	}
+
+	return rg
+}
+
+// Returns the index of the first edge v-w in edges, -1 if there is none
+func edgeIndex(edges []Edge, v, w int) int {
+	for i, e := range edges {
+		if e.Other(v) == w {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Returns the digraph with the edges v->w and w->v for every edge v-w, a
+// self-loop becomes a single directed edge.
+func (g *EdgeWeightedGraph) ToDigraph() *EdgeWeightedDigraph {
+	dg := NewEdgeWeightedDigraph(g.nVertices)
+	for _, e := range g.Edges() {
+		v := e.Either()
+		w := e.Other(v)
+		dg.AddWeightedEdge(NewDirectedEdge(v, w, e.Weight()))
+		if v != w {
+			dg.AddWeightedEdge(NewDirectedEdge(w, v, e.Weight()))
+		}
+	}
+
+	return dg
+}
+
+// Edge weighted directed graph
+//
+// The edges added through the Graph interface by AddEdge() get weight 1.
+type EdgeWeightedDigraph struct {
+	// Adjacency map of the graph nodes
+	adjMap map[int][]DirectedEdge
+
+	// Number of edges pointing to each vertex
+	inDegree map[int]int
+
+	nVertices int
+	nEdges    int
+}
+
+func NewEdgeWeightedDigraph(nVertices int) *EdgeWeightedDigraph {
+	g := &EdgeWeightedDigraph{
+		adjMap:    map[int][]DirectedEdge{},
+		inDegree:  map[int]int{},
+		nVertices: nVertices,
+	}
+
+	return g
+}
+
+func (g *EdgeWeightedDigraph) GetNumVertices() int {
+	return g.nVertices
+}
+
+func (g *EdgeWeightedDigraph) GetNumEdges() int {
+	return g.nEdges
+}
+
+func (g *EdgeWeightedDigraph) AddVertex() int {
+	g.nVertices++
+	return g.nVertices - 1
+}
+
+func (g *EdgeWeightedDigraph) HasVertex(v int) bool {
+	return v >= 0 && v < g.nVertices
+}
+
+// Adds the edge v->w with weight 1
+func (g *EdgeWeightedDigraph) AddEdge(v, w int) {
+	g.AddWeightedEdge(NewDirectedEdge(v, w, 1))
+}
+
+func (g *EdgeWeightedDigraph) AddWeightedEdge(e DirectedEdge) {
+	g.adjMap[e.From()] = append(g.adjMap[e.From()], e)
+	g.inDegree[e.To()]++
+	g.nEdges++
+}
+
+func (g *EdgeWeightedDigraph) GetNeighbors(v int) []int {
+	edges, ok := g.adjMap[v]
+	if !ok {
+		return nil
+	}
+
+	neighbors := make([]int, len(edges))
+	for i, e := range edges {
+		neighbors[i] = e.To()
+	}
+	return neighbors
+}
+
+// Returns an array containing all the edges pointing from v
+func (g *EdgeWeightedDigraph) Adj(v int) []DirectedEdge {
+	edges, ok := g.adjMap[v]
+	if !ok {
+		return nil
+	}
+
+	copyEdges := make([]DirectedEdge, len(edges))
+	copy(copyEdges, edges)
+	return copyEdges
+}
+
+// Returns all the edges of the graph
+func (g *EdgeWeightedDigraph) Edges() []DirectedEdge {
+	edges := make([]DirectedEdge, 0, g.nEdges)
+	for v := 0; v < g.nVertices; v++ {
+		edges = append(edges, g.adjMap[v]...)
+	}
+
+	return edges
+}
+
+// Returns the number of edges pointing from v
+func (g *EdgeWeightedDigraph) OutDegree(v int) int {
+	return len(g.adjMap[v])
+}
+
+// Returns the number of edges pointing to v
+func (g *EdgeWeightedDigraph) InDegree(v int) int {
+	return g.inDegree[v]
+}
+
+func (g *EdgeWeightedDigraph) IsDirected() bool {
+	return true
+}
+
+// Returns true if the graph has an edge v->w
+func (g *EdgeWeightedDigraph) HasEdge(v, w int) bool {
+	return directedEdgeIndex(g.adjMap[v], w) >= 0
+}
+
+// Removes one edge v->w, returns false if there is no such edge
+func (g *EdgeWeightedDigraph) RemoveEdge(v, w int) bool {
+	i := directedEdgeIndex(g.adjMap[v], w)
+	if i < 0 {
+		return false
+	}
+
+	g.adjMap[v] = append(g.adjMap[v][:i], g.adjMap[v][i+1:]...)
+	g.inDegree[w]--
+	g.nEdges--
+	return true
+}
+
+// Removes v and all the edges incident to it, the vertices after v move down
+// by one. Returns the new id of every old vertex, -1 for v, or nil if the
+// graph has no vertex v.
+func (g *EdgeWeightedDigraph) RemoveVertex(v int) []int {
+	if !g.HasVertex(v) {
+		return nil
+	}
+
+	ids := removedVertexIDs(g.nVertices, v)
+	edges := g.Edges()
+	g.adjMap = map[int][]DirectedEdge{}
+	g.inDegree = map[int]int{}
+	g.nVertices--
+	g.nEdges = 0
+	for _, e := range edges {
+		if e.From() != v && e.To() != v {
+			g.AddWeightedEdge(NewDirectedEdge(ids[e.From()], ids[e.To()], e.Weight()))
+		}
+	}
+
+	return ids
+}
+
+// Returns a new graph with the direction of every edge reversed
+func (g *EdgeWeightedDigraph) Reverse() *EdgeWeightedDigraph {
+	rg := NewEdgeWeightedDigraph(g.nVertices)
+	for _, e := range g.Edges() {
+		rg.AddWeightedEdge(NewDirectedEdge(e.To(), e.From(), e.Weight()))
+	}
+
+	return rg
+}
+
+// Returns the index of the first edge pointing to w in edges, -1 if there is none
+func directedEdgeIndex(edges []DirectedEdge, w int) int {
+	for i, e := range edges {
+		if e.To() == w {
+			return i
+		}
+	}
+
+	return -1
+}
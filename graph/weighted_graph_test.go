@@ -0,0 +1,128 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEdge(t *testing.T) {
+	e := NewEdge(3, 5, 0.25)
+	assert.Equal(t, 3, e.Either())
+	assert.Equal(t, 5, e.Other(3))
+	assert.Equal(t, 3, e.Other(5))
+	assert.Equal(t, 0.25, e.Weight())
+	assert.Equal(t, "3-5 0.25000", e.String())
+
+	de := NewDirectedEdge(3, 5, 0.25)
+	assert.Equal(t, 3, de.From())
+	assert.Equal(t, 5, de.To())
+	assert.Equal(t, 0.25, de.Weight())
+	assert.Equal(t, "3->5 0.25000", de.String())
+}
+
+func TestEdgeWeightedGraph(t *testing.T) {
+	g := NewEdgeWeightedGraph(4)
+	assert.Equal(t, false, g.IsDirected())
+
+	g.AddWeightedEdge(NewEdge(0, 1, 0.5))
+	g.AddWeightedEdge(NewEdge(1, 2, 1.5))
+	g.AddWeightedEdge(NewEdge(2, 2, 2.5))
+	g.AddEdge(3, 0)
+	assert.Equal(t, 4, g.GetNumEdges())
+
+	assert.Equal(t, []Edge{NewEdge(0, 1, 0.5), NewEdge(1, 2, 1.5)}, g.Adj(1))
+	assert.Equal(t, []int{0, 2}, g.GetNeighbors(1))
+	assert.Equal(t, []int{1, 2}, g.GetNeighbors(2))
+	assert.Equal(t, 3, g.Degree(2))
+
+	edges := g.Edges()
+	assert.Equal(t, 4, len(edges))
+	assert.Contains(t, edges, NewEdge(3, 0, 1))
+	assert.Contains(t, edges, NewEdge(2, 2, 2.5))
+
+	dg := g.ToDigraph()
+	assert.Equal(t, 7, dg.GetNumEdges())
+	assert.Equal(t, []DirectedEdge{NewDirectedEdge(2, 1, 1.5), NewDirectedEdge(2, 2, 2.5)}, dg.Adj(2))
+
+	v := g.AddVertex()
+	assert.Equal(t, 4, v)
+	assert.Nil(t, g.Adj(v))
+}
+
+func TestEdgeWeightedDigraph(t *testing.T) {
+	g := NewEdgeWeightedDigraph(3)
+	assert.Equal(t, true, g.IsDirected())
+
+	g.AddWeightedEdge(NewDirectedEdge(0, 1, 0.5))
+	g.AddWeightedEdge(NewDirectedEdge(0, 2, 1.5))
+	g.AddEdge(2, 1)
+	assert.Equal(t, 3, g.GetNumEdges())
+
+	assert.Equal(t, []DirectedEdge{NewDirectedEdge(0, 1, 0.5), NewDirectedEdge(0, 2, 1.5)}, g.Adj(0))
+	assert.Equal(t, []int{1, 2}, g.GetNeighbors(0))
+	assert.Nil(t, g.GetNeighbors(1))
+	assert.Equal(t, 2, g.OutDegree(0))
+	assert.Equal(t, 2, g.InDegree(1))
+	assert.Equal(t, 0, g.InDegree(0))
+	assert.Equal(t, []DirectedEdge{
+		NewDirectedEdge(0, 1, 0.5),
+		NewDirectedEdge(0, 2, 1.5),
+		NewDirectedEdge(2, 1, 1),
+	}, g.Edges())
+
+	bfs := NewSearch(BreathFirstSearch)
+	bfs.DoSearch(g, 0, 1)
+	assert.Equal(t, []int{0, 1}, bfs.PathTo(1))
+}
+
+func TestEdgeWeightedGraph_Remove(t *testing.T) {
+	g := NewEdgeWeightedGraph(4)
+	g.AddWeightedEdge(NewEdge(0, 1, 0.5))
+	g.AddWeightedEdge(NewEdge(1, 0, 1.5))
+	g.AddWeightedEdge(NewEdge(1, 2, 2.5))
+	g.AddWeightedEdge(NewEdge(3, 3, 3.5))
+	g.AddWeightedEdge(NewEdge(2, 3, 4.5))
+
+	assert.Equal(t, true, g.HasEdge(1, 2))
+	assert.Equal(t, false, g.HasEdge(0, 2))
+	assert.Equal(t, g.Edges(), g.Reverse().Edges())
+
+	// The first edge of 1 to 0 is removed from both end points
+	assert.Equal(t, true, g.RemoveEdge(1, 0))
+	assert.Equal(t, []Edge{NewEdge(1, 0, 1.5)}, g.Adj(0))
+	assert.Equal(t, []Edge{NewEdge(1, 0, 1.5), NewEdge(1, 2, 2.5)}, g.Adj(1))
+	assert.Equal(t, true, g.RemoveEdge(3, 3))
+	assert.Equal(t, false, g.RemoveEdge(3, 3))
+	assert.Equal(t, 3, g.GetNumEdges())
+
+	assert.Equal(t, []int{0, -1, 1, 2}, g.RemoveVertex(1))
+	assert.Equal(t, 3, g.GetNumVertices())
+	assert.Equal(t, []Edge{NewEdge(1, 2, 4.5)}, g.Edges())
+	assert.Nil(t, g.Adj(0))
+}
+
+func TestEdgeWeightedDigraph_Remove(t *testing.T) {
+	g := NewEdgeWeightedDigraph(3)
+	g.AddWeightedEdge(NewDirectedEdge(0, 1, 0.5))
+	g.AddWeightedEdge(NewDirectedEdge(0, 2, 1.5))
+	g.AddWeightedEdge(NewDirectedEdge(2, 1, 2.5))
+
+	assert.Equal(t, true, g.HasEdge(0, 2))
+	assert.Equal(t, false, g.HasEdge(2, 0))
+
+	rg := g.Reverse()
+	assert.Equal(t, []DirectedEdge{NewDirectedEdge(1, 0, 0.5), NewDirectedEdge(1, 2, 2.5)}, rg.Adj(1))
+	assert.Equal(t, 2, rg.OutDegree(1))
+	assert.Equal(t, 2, rg.InDegree(0))
+
+	assert.Equal(t, true, g.RemoveEdge(0, 1))
+	assert.Equal(t, false, g.RemoveEdge(0, 1))
+	assert.Equal(t, 1, g.InDegree(1))
+	assert.Equal(t, 2, g.GetNumEdges())
+
+	assert.Equal(t, []int{-1, 0, 1}, g.RemoveVertex(0))
+	assert.Equal(t, []DirectedEdge{NewDirectedEdge(1, 0, 2.5)}, g.Edges())
+	assert.Equal(t, 1, g.InDegree(0))
+	assert.Equal(t, 0, g.InDegree(1))
+}
@@ -37,6 +37,8 @@ type UnionFind interface {
 	Find(p int) (int, error)          // Return component identifier of p
 	Connected(p, q int) (bool, error) // Checks if p and q are connected
 	Union(p, q int) error             // Connects p and q
+	Size(p int) (int, error)          // Return the number of elements connected to p
+	Add() int                         // Adds a new disjoint set and returns its element
 }
 
 type weightedUnionFind struct {
@@ -115,3 +117,20 @@ func (uf *weightedUnionFind) Union(p, q int) error {
 	uf.count--
 	return nil
 }
+
+func (uf *weightedUnionFind) Size(p int) (int, error) {
+	i, err := uf.Find(p)
+	if err != nil {
+		return 0, err
+	}
+
+	return uf.size[i], nil
+}
+
+func (uf *weightedUnionFind) Add() int {
+	p := len(uf.id)
+	uf.id = append(uf.id, p)
+	uf.size = append(uf.size, 1)
+	uf.count++
+	return p
+}
@@ -39,3 +39,30 @@ func TestUF(t *testing.T) {
 	assert.Equal(t, true, p == q)
 	assert.Equal(t, 7, uf.Count())
 }
+
+func TestUF_SizeAndAdd(t *testing.T) {
+	uf := NewUF(3)
+
+	size, err := uf.Size(0)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, size)
+
+	uf.Union(0, 1)
+	size, err = uf.Size(1)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, size)
+
+	_, err = uf.Size(3)
+	assert.Error(t, err)
+
+	p := uf.Add()
+	assert.Equal(t, 3, p)
+	assert.Equal(t, 3, uf.Count())
+
+	uf.Union(p, 2)
+	uf.Union(p, 0)
+	assert.Equal(t, 1, uf.Count())
+	size, err = uf.Size(2)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, size)
+}